@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	c "github.com/jroimartin/gocui"
@@ -9,7 +10,6 @@ import (
 	// l "nl/vdb/dagstertui/log"
 	"os"
 	"os/exec"
-	"regexp"
 	"runtime"
 	"strings"
 	"time"
@@ -18,16 +18,37 @@ import (
 var (
 	ConfirmationView    *s.ListView[string]
 	LaunchRunWindow     *s.InfoView
+	LaunchErrorsView    *s.InfoView
+	LaunchTagsView      *s.InfoView
+	LaunchNameView      *s.InfoView
+	LaunchPartitionView *s.InfoView
 	FeedbackView        *s.InfoView
 	KeyMappingsView     *s.InfoView
 	EnvironmentInfoView *s.InfoView
+	StatusView          *s.InfoView
 	RunInfoWindow       *s.InfoView
 	FilterView          *s.InfoView
+	LogsWindow          *s.InfoView
+	SensorTicksView     *s.InfoView
+	AssetHistoryWindow  *s.InfoView
+	AssetLineageWindow  *s.InfoView
+	StepQueryView       *s.InfoView
+	ComputeLogsWindow   *s.InfoView
+	RunsFilterView      *s.InfoView
+	RunDiffWindow       *s.InfoView
 
 	RunsWindow *s.ListView[s.RunRepresentation]
 	RepoWindow *s.ListView[s.RepositoryRepresentation]
 	JobsWindow *s.ListView[s.JobRepresentation]
 
+	PartitionsWindow *s.ListView[s.PartitionRepresentation]
+	BackfillsWindow  *s.ListView[s.Backfill]
+	SchedulesWindow  *s.ListView[s.Schedule]
+	SensorsWindow    *s.ListView[s.Sensor]
+	AssetsWindow     *s.ListView[s.AssetNode]
+	StepsWindow      *s.ListView[s.StepStats]
+	RunsSearchWindow *s.ListView[s.Run]
+
 	Overview *s.Overview
 	State    *ApplicationState
 	Conf     Config
@@ -45,8 +66,28 @@ const (
 	RUN_INFO_VIEW     = "run_info"
 	KEY_MAPPINGS_VIEW = "keymaps"
 	LAUNCH_RUN_VIEW   = "launch_run"
+	LAUNCH_ERRORS     = "launch_errors"
+	LAUNCH_TAGS_VIEW  = "launch_tags"
+	LAUNCH_NAME_VIEW  = "launch_name"
+	LAUNCH_PARTITION  = "launch_partition"
+	PARTITIONS_VIEW   = "partitions"
+	BACKFILLS_VIEW    = "backfills"
+	SCHEDULES_VIEW    = "schedules"
+	SENSORS_VIEW      = "sensors"
+	SENSOR_TICKS_VIEW = "sensor_ticks"
+	ASSETS_VIEW       = "assets"
+	ASSET_HISTORY     = "asset_history"
+	LINEAGE_VIEW      = "lineage"
+	STEPS_VIEW        = "steps"
+	STEP_QUERY_VIEW   = "step_query"
+	COMPUTE_LOGS_VIEW = "compute_logs"
+	RUN_DIFF_VIEW     = "run_diff"
+	RUNS_SEARCH_VIEW  = "runs_search"
+	SEARCH_FILTER     = "runs_search_filter"
 	FILTER_VIEW       = "filter"
 	ENVIRONMENT_INFO  = "environment"
+	STATUS_VIEW       = "status"
+	LOGS_VIEW         = "logs"
 
 	FEEDBACK_VIEW     = "feedback"
 	CONFIRMATION_VIEW = "confirmation"
@@ -59,15 +100,37 @@ type ApplicationState struct {
 	SelectedRun          string
 
 	RepoFilter string
+
+	// window to return to when a popup gets closed, popups can be opened on top of other popups
+	popupReturns map[string]string
 }
 
 func (a *ApplicationState) SetNewActiveWindow(g *c.Gui, previousWindow string, currentWindow string) error {
 	a.PreviousActiveWindow = previousWindow
+	if a.popupReturns == nil {
+		a.popupReturns = make(map[string]string)
+	}
+	a.popupReturns[currentWindow] = previousWindow
 	return SetFocus(g, currentWindow, previousWindow)
 }
 
+// popupReturnWindow returns the window that was active when the popup got opened
+func (a *ApplicationState) popupReturnWindow(popup string) string {
+	if window, ok := a.popupReturns[popup]; ok {
+		delete(a.popupReturns, popup)
+		return window
+	}
+	return a.PreviousActiveWindow
+}
+
 type Config struct {
 	Environments map[string]string `json:"environments"`
+	// seconds between refreshes of the repositories and runs, 0 disables refreshing
+	RefreshInterval int `json:"refreshInterval"`
+	// shell command run when a watched run finishes, the run is passed in the DAGSTER_RUN_* environment variables
+	NotifyCommand string `json:"notifyCommand"`
+	// number of runs loaded per page, defaultRunsPageSize when not set
+	RunsPageSize int `json:"runsPageSize"`
 }
 
 func LoadConfig(dir string) {
@@ -97,10 +160,30 @@ func InitializeViews(g *c.Gui) error {
 	RunInfoWindow = &s.InfoView{}
 	FeedbackView = &s.InfoView{}
 	EnvironmentInfoView = &s.InfoView{}
+	StatusView = &s.InfoView{}
 	FilterView = &s.InfoView{}
 	ConfirmationView = &s.ListView[string]{}
 	LaunchRunWindow = &s.InfoView{}
+	LaunchErrorsView = &s.InfoView{}
+	LaunchTagsView = &s.InfoView{}
+	LaunchNameView = &s.InfoView{}
+	LaunchPartitionView = &s.InfoView{}
+	PartitionsWindow = &s.ListView[s.PartitionRepresentation]{}
+	BackfillsWindow = &s.ListView[s.Backfill]{}
+	SchedulesWindow = &s.ListView[s.Schedule]{}
+	SensorsWindow = &s.ListView[s.Sensor]{}
+	SensorTicksView = &s.InfoView{}
+	AssetsWindow = &s.ListView[s.AssetNode]{}
+	AssetHistoryWindow = &s.InfoView{}
+	AssetLineageWindow = &s.InfoView{}
+	StepsWindow = &s.ListView[s.StepStats]{}
+	StepQueryView = &s.InfoView{}
+	ComputeLogsWindow = &s.InfoView{}
+	RunsFilterView = &s.InfoView{}
+	RunDiffWindow = &s.InfoView{}
+	RunsSearchWindow = &s.ListView[s.Run]{}
 	KeyMappingsView = &s.InfoView{}
+	LogsWindow = &s.InfoView{}
 
 	RepoWindow.Initialize(g, "Repositories", REPOSITORIES_VIEW,
 		func(a s.RepositoryRepresentation) string { return a.Location },
@@ -109,11 +192,14 @@ func InitializeViews(g *c.Gui) error {
 		func(a s.JobRepresentation) string { return a.Name },
 		func(a s.JobRepresentation) string { return a.Name })
 	RunsWindow.Initialize(g, "Runs", RUNS_VIEW,
-		func(a s.RunRepresentation) string { return fmt.Sprintf("%s \t %s", a.Status, a.RunId) },
+		func(a s.RunRepresentation) string {
+			return fmt.Sprintf("%s \t %s%s%s", a.Status, a.RunId, watchedMarker(a.RunId), diffMarker(a.RunId))
+		},
 		func(a s.RunRepresentation) string { return fmt.Sprint(a.StartTime) })
 
 	RunInfoWindow.Initialize(g, "Run Info", RUN_INFO_VIEW)
 	EnvironmentInfoView.Initialize(g, "Dagster Info", ENVIRONMENT_INFO)
+	StatusView.Initialize(g, "Status", STATUS_VIEW)
 	FilterView.Initialize(g, "Filter", FILTER_VIEW)
 
 	FilterView.Base.View.Editable = true
@@ -170,7 +256,9 @@ func OpenInBrowser(g *c.Gui, v *c.View) error {
 	case RUNS_VIEW:
 		runId := State.SelectedRun
 		if runId == "" {
-			runId = Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, RunsWindow.GetElementOnCursorPosition()).RunId
+			if run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, RunsWindow.GetElementOnCursorPosition()); ok {
+				runId = run.RunId
+			}
 		}
 		if runId != "" {
 			openbrowser(fmt.Sprintf("%s/runs/%s", Overview.Url, runId))
@@ -190,79 +278,32 @@ func OpenPopupKeyMaps(g *c.Gui, v *c.View) error {
 	return State.SetNewActiveWindow(g, v.Name(), KEY_MAPPINGS_VIEW)
 }
 
-var DefaultEditor c.Editor = c.EditorFunc(simpleEditor)
-var FilterEditor c.Editor = c.EditorFunc(filterEditor)
-
-func filterEditor(v *c.View, key c.Key, ch rune, mod c.Modifier) {
-	switch {
-	case ch != 0 && mod == 0:
-		v.EditWrite(ch)
-	case key == c.KeyBackspace || key == c.KeyBackspace2:
-		v.EditDelete(true)
-	}
-	FilterItemsInView(v)
-}
-
-func simpleEditor(v *c.View, key c.Key, ch rune, mod c.Modifier) {
-	switch {
-	case key == c.KeyArrowDown:
-		v.MoveCursor(0, 1, false)
-	case key == c.KeyArrowUp:
-		v.MoveCursor(0, -1, false)
-	case key == c.KeyArrowLeft:
-		v.MoveCursor(-1, 0, false)
-	case key == c.KeyArrowRight:
-		v.MoveCursor(1, 0, false)
-	case key == c.KeyCtrlSlash:
-		x, y := v.Cursor()
-		currentLine, _ := v.Line(y)
-
-		re := regexp.MustCompile(`^[\s]*#`) // any amount of whitespaces followed by # at the beginning of a line
-		if re.MatchString(currentLine) {
-			index := strings.Index(currentLine, "#")
-			v.SetCursor(index, y)
-			v.EditDelete(false)
-		} else {
-			v.SetCursor(0, y)
-			v.EditWrite('#')
-		}
-		v.SetCursor(x, y+1)
-	case key == c.KeySpace:
-		v.EditWrite(' ')
-	case key == c.KeyBackspace || key == c.KeyBackspace2:
-		v.EditDelete(true)
-	case ch != 0 && mod == 0:
-		v.EditWrite(ch)
-	}
-}
-
 func OpenPopupLaunchWindow(g *c.Gui, v *c.View) error {
-	maxX, maxY := g.Size()
-
-	LaunchRunWindow.Initialize(g, "Launch Run For", LAUNCH_RUN_VIEW)
-	LaunchRunWindow.Base.RenderView(g, int(float64(maxX)*0.2), int(float64(maxY)*0.2), int(float64(maxX)*0.8), int(float64(maxY)*0.8))
-
-	LaunchRunWindow.Base.View.Editable = true
-	LaunchRunWindow.Base.View.Editor = DefaultEditor
-	LaunchRunWindow.Base.View.Highlight = true
-	LaunchRunWindow.Base.View.SelBgColor = c.ColorBlue
-	LaunchRunWindow.Base.View.SetCursor(0, 0)
+	if State.SelectedJob == "" || (v.Name() == RUNS_VIEW && len(RunsWindow.Elements) == 0) {
+		return nil
+	}
 
 	runConfig := ""
+	tags := []s.Tag{}
 	if v.Name() == RUNS_VIEW {
 		SelectedRun := RunsWindow.GetElementOnCursorPosition()
-		runConfig = Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun).RunconfigYaml
+		run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+		if !ok {
+			return nil
+		}
+		runConfig = run.RunconfigYaml
+		tags = templateTags(run.Tags)
 	} else {
 		runConfig = Overview.Repositories[State.SelectedRepo].Jobs[State.SelectedJob].DefaultRunConfigYaml
 	}
 
-	LaunchRunWindow.RenderContent([]string{runConfig})
-
-	return State.SetNewActiveWindow(g, v.Name(), LAUNCH_RUN_VIEW)
+	return openLaunchPopup(g, v, selectedJobTarget(), runConfig, tags)
 }
 
 func ClosePopupView(g *c.Gui, v *c.View) error {
-	err := State.SetNewActiveWindow(g, v.Name(), State.PreviousActiveWindow)
+	returnWindow := State.popupReturnWindow(v.Name())
+	State.PreviousActiveWindow = v.Name()
+	err := SetFocus(g, returnWindow, v.Name())
 	if err = g.DeleteView(v.Name()); err != nil {
 		return err
 	}
@@ -279,43 +320,123 @@ func OpenConfirmationWindow(g *c.Gui, message string, options []string) error {
 	return nil
 }
 
+// the feedback popup can open on top of other popups, so it remembers which window to return to
+// and what the previous active window was at that point
+var feedbackReturn struct {
+	window   string
+	previous string
+}
+
+func openFeedbackPopup(g *c.Gui, title string, message string, returnWindow string) error {
+	maxX, maxY := g.Size()
+	lines := strings.Split(message, "\n")
+	width := len(title) + 2
+	for _, line := range lines {
+		if len(line) > width {
+			width = len(line)
+		}
+	}
+	endX := 10 + width + 1
+	if endX > maxX-2 {
+		endX = maxX - 2
+	}
+	endY := 10 + len(lines) + 1
+	if endY > maxY-2 {
+		endY = maxY - 2
+	}
+
+	if current := g.CurrentView(); current == nil || current.Name() != FEEDBACK_VIEW {
+		feedbackReturn.window = returnWindow
+		feedbackReturn.previous = State.PreviousActiveWindow
+	}
+
+	FeedbackView.Initialize(g, title, FEEDBACK_VIEW)
+	FeedbackView.Base.RenderView(g, 10, 10, endX, endY)
+	FeedbackView.Base.View.Wrap = true
+	FeedbackView.Base.View.FgColor = c.ColorDefault
+	FeedbackView.RenderContent(lines)
+	return State.SetNewActiveWindow(g, feedbackReturn.window, FEEDBACK_VIEW)
+}
+
 func OpenFeedbackWindow(g *c.Gui, v *c.View, message string) error {
-	lengthOfMessage := len(message)
-	FeedbackView.Initialize(g, "Termination Response", FEEDBACK_VIEW)
-	FeedbackView.Base.RenderView(g, 10, 10, 10+lengthOfMessage, 20)
-	FeedbackView.RenderContent([]string{message})
-	return State.SetNewActiveWindow(g, RUNS_VIEW, FEEDBACK_VIEW)
+	return openFeedbackPopup(g, "Termination Response", message, RUNS_VIEW)
 }
 
-func ShowTerminationOptions(g *c.Gui, v *c.View) error {
-	OpenConfirmationWindow(g, "Terminate run?", []string{"Yes", "No"})
+func OpenErrorWindow(g *c.Gui, err error) error {
+	returnWindow := REPOSITORIES_VIEW
+	if current := g.CurrentView(); current != nil {
+		returnWindow = current.Name()
+	}
+	if err := openFeedbackPopup(g, "Error - ESC to dismiss", err.Error(), returnWindow); err != nil {
+		return err
+	}
+	FeedbackView.Base.View.FgColor = c.ColorRed
+	return nil
+}
+
+func CloseFeedbackWindow(g *c.Gui, v *c.View) error {
+	if err := SetFocus(g, feedbackReturn.window, FEEDBACK_VIEW); err != nil {
+		return err
+	}
+	State.PreviousActiveWindow = feedbackReturn.previous
+	return g.DeleteView(FEEDBACK_VIEW)
+}
+
+// pendingConfirmation is called when "Yes" gets selected in the confirmation window
+var pendingConfirmation func(*c.Gui) error
+
+func AskConfirmation(g *c.Gui, v *c.View, message string, onYes func(*c.Gui) error) error {
+	pendingConfirmation = onYes
+	OpenConfirmationWindow(g, message, []string{"Yes", "No"})
 	return State.SetNewActiveWindow(g, v.Name(), CONFIRMATION_VIEW)
 }
 
-func TerminateRunWithConfirmationByRunId(g *c.Gui, v *c.View) error {
-	d := ConfirmationView.GetElementOnCursorPosition()
-	if d == "Yes" {
-		ClosePopupView(g, ConfirmationView.Base.View)
-		return TerminateRunByRunId(g, v)
+func ConfirmSelection(g *c.Gui, v *c.View) error {
+	answer := ConfirmationView.GetElementOnCursorPosition()
+	onYes := pendingConfirmation
+	pendingConfirmation = nil
+	if err := ClosePopupView(g, ConfirmationView.Base.View); err != nil {
+		return err
+	}
+	if answer == "Yes" && onYes != nil {
+		return onYes(g)
 	}
 	return nil
 }
 
+func ShowTerminationOptions(g *c.Gui, v *c.View) error {
+	return AskConfirmation(g, v, "Terminate run?", func(g *c.Gui) error {
+		return TerminateRunByRunId(g, RunsWindow.Base.View)
+	})
+}
+
 func TerminateRunByRunId(g *c.Gui, v *c.View) error {
 	SelectedRun := RunsWindow.GetElementOnCursorPosition()
-	run := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
-	resp := Client.TerminateRun(run.RunId)
+	if SelectedRun == "" {
+		return nil
+	}
+	run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+	if !ok {
+		return nil
+	}
 
-	respStr := fmt.Sprintf("Termination Request of type: %s \n\n %s", resp.Data.TerminateRun.TypeName, resp.Data.TerminateRun.Message)
-	LoadRuns(g, JobsWindow.Base.View)
-	OpenFeedbackWindow(g, v, respStr)
+	MutateAsync(g, "terminate_run", RunsWindow.Base,
+		func(ctx context.Context) (s.TerminateRunResponse, error) { return Client.TerminateRun(ctx, run.RunId) },
+		func(g *c.Gui, resp s.TerminateRunResponse) error {
+			respStr := fmt.Sprintf("Termination Request of type: %s \n\n %s", resp.TerminateRun.TypeName, resp.TerminateRun.Message)
+			LoadRuns(g, JobsWindow.Base.View)
+			return OpenFeedbackWindow(g, v, respStr)
+		})
 	return nil
 }
 
 func setRunInformation(v *c.View) {
-	if v.Name() == RUNS_VIEW && len(v.ViewBufferLines()) > 0 {
+	if v.Name() == RUNS_VIEW && len(RunsWindow.Elements) > 0 {
 		SelectedRun := RunsWindow.GetElementOnCursorPosition()
-		run := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+		run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+		if !ok {
+			return
+		}
 		runInfo := make([]string, 0)
 
 		s := time.Unix(int64(run.StartTime), 0)
@@ -326,6 +447,9 @@ func setRunInformation(v *c.View) {
 		runInfo = append(runInfo, fmt.Sprintf("End\t\t %s", e.Local().Format("2006-01-02 15:04:05")))
 		runInfo = append(runInfo, fmt.Sprintf("Duration\t\t %s", duration.String()))
 		runInfo = append(runInfo, fmt.Sprintf("Status\t\t %s", run.Status))
+		if name := runName(run.Tags); name != "" {
+			runInfo = append(runInfo, fmt.Sprintf("Name\t\t %s", name))
+		}
 
 		RunInfoWindow.RenderContent(runInfo)
 	}
@@ -338,6 +462,13 @@ func CursorUpAndUpdateRunInfo(g *c.Gui, v *c.View) error {
 }
 
 func CursorDownAndUpdateRunInfo(g *c.Gui, v *c.View) error {
+	// scrolling past the last run loads the next page instead of wrapping around
+	_, oy := v.Origin()
+	_, y := v.Cursor()
+	if !allRunsLoaded && len(RunsWindow.RawElements) > 0 && oy+y == len(RunsWindow.RawElements)-1 {
+		LoadMoreRuns(g)
+		return nil
+	}
 	err := CursorDown(g, v)
 	setRunInformation(v)
 	return err
@@ -346,10 +477,7 @@ func CursorDownAndUpdateRunInfo(g *c.Gui, v *c.View) error {
 func FilterItemsInView(v *c.View) error {
 	switch State.PreviousActiveWindow {
 	case REPOSITORIES_VIEW:
-		filterTerm := FilterView.Base.View.BufferLines()[0]
-		cond_contains_term := func(repo s.RepositoryRepresentation) bool { return strings.Contains(repo.Location, filterTerm) }
-		currentRepositoriesList := s.Filter(Overview.GetRepositoryList(), cond_contains_term)
-		RepoWindow.RenderItems(currentRepositoriesList)
+		RepoWindow.RenderItems(filteredRepositories())
 	default:
 		return nil
 
@@ -357,49 +485,169 @@ func FilterItemsInView(v *c.View) error {
 	return nil
 }
 
+// filteredRepositories returns the repositories matching the term in the filter view
+func filteredRepositories() []s.RepositoryRepresentation {
+	filterTerm := ""
+	if lines := FilterView.Base.View.BufferLines(); len(lines) > 0 {
+		filterTerm = lines[0]
+	}
+	cond_contains_term := func(repo s.RepositoryRepresentation) bool { return strings.Contains(repo.Location, filterTerm) }
+	return s.Filter(Overview.GetRepositoryList(), cond_contains_term)
+}
+
 func SwitchToFilterView(g *c.Gui, v *c.View) error {
 	FilterView.Base.Title = fmt.Sprintf("Filter %s", v.Title)
 	return State.SetNewActiveWindow(g, v.Name(), FILTER_VIEW)
 }
 
+func LoadRepositories(g *c.Gui) {
+	FetchAsync(g, REPOSITORIES_VIEW, RepoWindow.Base, Client.LoadRepositories, func(g *c.Gui, repos []s.Repository) error {
+		Overview.AppendRepositories(repos)
+		RepoWindow.RenderItems(Overview.GetRepositoryList())
+		return nil
+	})
+}
 
 func LoadJobsForRepository(g *c.Gui, v *c.View) error {
 
 	locationName := RepoWindow.GetElementOnCursorPosition()
+	if locationName == "" {
+		return nil
+	}
 	State.SelectedRepo = locationName
 
 	repo := Overview.GetRepoByLocation(locationName)
 
-	Overview.AppendJobsToRepository(repo.Location, Client.GetJobsInRepository(repo))
-
 	JobsWindow.Base.Title = fmt.Sprintf("%s - Jobs", locationName)
-	JobsWindow.RenderItems(Overview.GetJobNamesInRepository(locationName))
-
+	// clear the jobs of the previous repository while the new ones are loading
+	JobsWindow.RenderItems([]s.JobRepresentation{})
 	JobsWindow.ResetCursor()
+
+	FetchAsync(g, JOBS_VIEW, JobsWindow.Base,
+		func(ctx context.Context) ([]s.Job, error) { return Client.GetJobsInRepository(ctx, repo) },
+		func(g *c.Gui, jobs []s.Job) error {
+			Overview.AppendJobsToRepository(repo.Location, jobs)
+			JobsWindow.RenderItems(Overview.GetJobNamesInRepository(locationName))
+			JobsWindow.ResetCursor()
+			return nil
+		})
+
 	return SetFocus(g, JOBS_VIEW, v.Name())
 
 }
 
-
-func LoadRuns(g *c.Gui, v* c.View) {
+func LoadRuns(g *c.Gui, v *c.View) {
 	jobName := JobsWindow.GetElementOnCursorPosition()
-	State.SelectedJob = jobName
+	if jobName == "" {
+		return
+	}
+	LoadRunsAndSelect(g, State.SelectedRepo, jobName, "")
+}
 
-	repo := Overview.GetRepoByLocation(State.SelectedRepo)
+// LoadRunsAndSelect selects the job of the repository, loads its runs and moves the cursor to the run with selectRunId
+func LoadRunsAndSelect(g *c.Gui, location string, jobName string, selectRunId string) {
+	State.SelectedRepo = location
+	State.SelectedJob = jobName
 
-	pipelineRuns := Client.GetPipelineRuns(repo, State.SelectedJob, 10)
-	Overview.UpdatePipelineAndRuns(repo.Location, pipelineRuns)
-	runs := Overview.GetRunsFor(State.SelectedRepo, State.SelectedJob)
-	// TODO make headers skippable in navigation
-	// runInfos = append(runInfos, "Status \t RunId \t Time")
+	repo := Overview.GetRepoByLocation(location)
 
-	RunsWindow.Base.Title = fmt.Sprintf("%s - Runs", State.SelectedJob)
-	RunsWindow.RenderItems(runs)
+	RunsWindow.Base.Title = fmt.Sprintf("%s - Runs", jobName)
+	allRunsLoaded = false
+	pageSize := runsPageSize()
+	// clear the runs of the previous job while the new ones are loading
+	RunsWindow.RenderItems([]s.RunRepresentation{})
 	RunsWindow.ResetCursor()
+	RunInfoWindow.RenderContent([]string{})
+
+	FetchAsync(g, RUNS_VIEW, RunsWindow.Base,
+		func(ctx context.Context) (s.PipelineOrError, error) {
+			return Client.GetPipelineRuns(ctx, repo, jobName, "", pageSize)
+		},
+		func(g *c.Gui, pipelineRuns s.PipelineOrError) error {
+			// another job got selected in the meantime
+			if State.SelectedRepo != location || State.SelectedJob != jobName {
+				return nil
+			}
+			allRunsLoaded = len(pipelineRuns.Runs) < pageSize
+			Overview.UpdatePipelineAndRuns(location, pipelineRuns)
+			runs := Overview.GetRunsFor(location, jobName)
+			// TODO make headers skippable in navigation
+			// runInfos = append(runInfos, "Status \t RunId \t Time")
+
+			// the runs are shown in the order dagster returns them, most recent first, so pages get added at the bottom
+			RunsWindow.RenderItems(runs, false)
+			RunsWindow.ResetCursor()
+			if selectRunId != "" {
+				RunsWindow.SelectElement(func(run s.RunRepresentation) bool { return run.RunId == selectRunId })
+			}
+			setRunsTitle(jobName)
+
+			setRunInformation(RunsWindow.Base.View)
+			return nil
+		})
+}
+
+// number of runs loaded per page when the config does not set runsPageSize
+const defaultRunsPageSize = 10
 
-	setRunInformation(RunsWindow.Base.View)
+// allRunsLoaded is set once the last page of runs of the selected job got loaded
+var allRunsLoaded bool
+
+func runsPageSize() int {
+	if Conf.RunsPageSize > 0 {
+		return Conf.RunsPageSize
+	}
+	return defaultRunsPageSize
 }
 
+func setRunsTitle(jobName string) {
+	title := fmt.Sprintf("%s - Runs - loaded %d runs", jobName, len(RunsWindow.RawElements))
+	if allRunsLoaded {
+		title = fmt.Sprintf("%s (all)", title)
+	}
+	RunsWindow.Base.SetTitle(title)
+}
+
+func sameRun(a s.RunRepresentation, b s.RunRepresentation) bool {
+	return a.RunId == b.RunId
+}
+
+// LoadMoreRuns fetches the page of runs after the last loaded run of the selected job, the cursor moves on
+// to the first run of that page
+func LoadMoreRuns(g *c.Gui) {
+	location, jobName := State.SelectedRepo, State.SelectedJob
+	// a page is already loading
+	if requestInFlight(RUNS_VIEW) || allRunsLoaded || location == "" || jobName == "" {
+		return
+	}
+	runs := Overview.GetRunsFor(location, jobName)
+	if len(runs) == 0 {
+		return
+	}
+	repo := Overview.GetRepoByLocation(location)
+	cursor := runs[len(runs)-1].RunId
+	pageSize := runsPageSize()
+
+	FetchAsync(g, RUNS_VIEW, RunsWindow.Base,
+		func(ctx context.Context) (s.PipelineOrError, error) {
+			return Client.GetPipelineRuns(ctx, repo, jobName, cursor, pageSize)
+		},
+		func(g *c.Gui, pipelineRuns s.PipelineOrError) error {
+			// another job got selected in the meantime
+			if State.SelectedRepo != location || State.SelectedJob != jobName {
+				return nil
+			}
+			allRunsLoaded = len(pipelineRuns.Runs) < pageSize
+			Overview.AppendRuns(location, pipelineRuns)
+			RunsWindow.ReplaceItems(Overview.GetRunsFor(location, jobName), sameRun, false)
+			if len(pipelineRuns.Runs) > 0 {
+				CursorDown(g, RunsWindow.Base.View)
+			}
+			setRunsTitle(jobName)
+			setRunInformation(RunsWindow.Base.View)
+			return nil
+		})
+}
 
 func LoadRunsForJob(g *c.Gui, v *c.View) error {
 	LoadRuns(g, v)
@@ -407,10 +655,29 @@ func LoadRunsForJob(g *c.Gui, v *c.View) error {
 }
 
 func ValidateAndLaunchRun(g *c.Gui, v *c.View) error {
+	target := currentLaunch
+	lines := runConfigLines()
+	tags, err := parseTags(LaunchTagsView.Base.View.BufferLines())
+	if err != nil {
+		return showLaunchFeedback(g, "Errors", []string{err.Error()}, c.ColorRed)
+	}
+	tags = withRunName(tags, launchRunName())
+	partition, err := launchPartition(target)
+	if err != nil {
+		return showLaunchFeedback(g, "Errors", []string{err.Error()}, c.ColorRed)
+	}
+	if partition != "" {
+		tags = withTag(tags, "dagster/partition", partition)
+	}
 
-	Client.LaunchRunForJob(*Overview.Repositories[State.SelectedRepo], State.SelectedJob, LaunchRunWindow.Base.View.BufferLines())
-	ClosePopupView(g, LaunchRunWindow.Base.View)
-	LoadRuns(g, JobsWindow.Base.View)
+	MutateAsync(g, "launch_run", LaunchRunWindow.Base,
+		func(ctx context.Context) (launchResult, error) {
+			runId, err := Client.LaunchRunForJob(ctx, target.repo, target.jobName, target.assetSelection, lines, tags)
+			return asLaunchResult(target, runId, err)
+		},
+		func(g *c.Gui, result launchResult) error {
+			return applyLaunchResult(g, result)
+		})
 
 	return nil
 }
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	s "nl/vdb/dagstertui/internal"
+)
+
+// GetAssetNodes returns the asset nodes of all code locations with their latest materialization
+func (c *GraphQLClient) GetAssetNodes(ctx context.Context) ([]s.AssetNode, error) {
+	query := `query AssetNodesQuery {
+		assetNodes {
+			id
+			assetKey {
+				path
+			}
+			groupName
+			jobNames
+			repository {
+				name
+				location {
+					name
+				}
+			}
+			staleStatus
+			assetMaterializations(limit: 1) {
+				runId
+				timestamp
+				partition
+				stepKey
+			}
+			dependencyKeys {
+				path
+			}
+			dependedByKeys {
+				path
+			}
+		}
+	}`
+
+	var response s.AssetNodesResponse
+	if err := c.Execute(ctx, query, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.AssetNodes, nil
+}
+
+func (c *GraphQLClient) GetAssetMaterializations(ctx context.Context, assetKey s.AssetKey, limit int) ([]s.AssetMaterialization, error) {
+	query := `query AssetMaterializationsQuery($assetKey: AssetKeyInput!, $limit: Int) {
+		assetOrError(assetKey: $assetKey) {
+			__typename
+			... on Asset {
+			assetMaterializations(limit: $limit) {
+				runId
+				timestamp
+				partition
+				stepKey
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"assetKey": map[string]any{
+			"path": assetKey.Path,
+		},
+		"limit": limit,
+	}
+
+	var response s.AssetMaterializationsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	asset := response.AssetOrError
+	if err := unionError(asset.TypeName, asset.Message, "Asset"); err != nil {
+		return nil, err
+	}
+	return asset.AssetMaterializations, nil
+}
+
+// GetAssetsLatestInfo returns the latest materialization and the latest run of each asset
+func (c *GraphQLClient) GetAssetsLatestInfo(ctx context.Context, assetKeys []s.AssetKey) ([]s.AssetLatestInfo, error) {
+	query := `query AssetsLatestInfoQuery($assetKeys: [AssetKeyInput!]!) {
+		assetsLatestInfo(assetKeys: $assetKeys) {
+			assetKey {
+				path
+			}
+			latestMaterialization {
+				timestamp
+				runId
+			}
+			latestRun {
+				runId
+				status
+			}
+			inProgressRunIds
+			unstartedRunIds
+		}
+	}`
+	variables := map[string]any{
+		"assetKeys": assetKeys,
+	}
+
+	var response s.AssetsLatestInfoResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.AssetsLatestInfo, nil
+}
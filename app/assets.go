@@ -0,0 +1,260 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+)
+
+const assetHistoryLimit = 50
+
+// ids of the assets selected for materialization
+var selectedAssets = make(map[string]bool)
+
+// widths of the group and asset key columns of ASSETS_VIEW, set before rendering so the columns line up
+var assetColumns struct {
+	group int
+	key   int
+}
+
+func assetGroup(asset s.AssetNode) string {
+	return fmt.Sprintf("%s / %s", asset.Repository.Location.Name, asset.GroupName)
+}
+
+func formatStaleStatus(status string) string {
+	switch status {
+	case "FRESH":
+		return fmt.Sprintf("\x1b[32m%s\x1b[0m", status)
+	case "STALE":
+		return fmt.Sprintf("\x1b[33m%s\x1b[0m", status)
+	case "MISSING":
+		return fmt.Sprintf("\x1b[31m%s\x1b[0m", status)
+	default:
+		return status
+	}
+}
+
+func formatAsset(asset s.AssetNode) string {
+	materialized, runId := "never materialized", ""
+	if len(asset.AssetMaterializations) > 0 {
+		latest := asset.AssetMaterializations[0]
+		materialized, runId = formatLogTimestamp(latest.Timestamp), latest.RunId
+	}
+	marker := "[ ]"
+	if selectedAssets[asset.Id] {
+		marker = "[x]"
+	}
+	return fmt.Sprintf("%s %-*s  %-*s  %-19s  %-36s  %s", marker,
+		assetColumns.group, assetGroup(asset), assetColumns.key, asset.AssetKey.String(), materialized, runId, formatStaleStatus(asset.StaleStatus))
+}
+
+func renderAssets(assets []s.AssetNode) {
+	assetColumns.group, assetColumns.key = 0, 0
+	for _, asset := range assets {
+		if len(assetGroup(asset)) > assetColumns.group {
+			assetColumns.group = len(assetGroup(asset))
+		}
+		if len(asset.AssetKey.String()) > assetColumns.key {
+			assetColumns.key = len(asset.AssetKey.String())
+		}
+	}
+	AssetsWindow.RenderItems(assets)
+}
+
+func LoadAssets(g *c.Gui) {
+	FetchAsync(g, ASSETS_VIEW, AssetsWindow.Base,
+		func(ctx context.Context) ([]s.AssetNode, error) {
+			return Client.GetAssetNodes(ctx)
+		},
+		func(g *c.Gui, assets []s.AssetNode) error {
+			// the assets window got closed while loading
+			if _, err := g.View(ASSETS_VIEW); err != nil {
+				return nil
+			}
+			selected, _ := AssetsWindow.GetRawElementOnCursorPosition()
+			renderAssets(assets)
+			if !AssetsWindow.SelectElement(func(asset s.AssetNode) bool { return asset.Id == selected.Id }) {
+				AssetsWindow.ResetCursor()
+			}
+			setAssetsTitle()
+			return nil
+		})
+}
+
+func setAssetsTitle() {
+	title := fmt.Sprintf("Assets (%d)", len(AssetsWindow.RawElements))
+	if len(selectedAssets) > 0 {
+		title = fmt.Sprintf("%s - %d selected", title, len(selectedAssets))
+	}
+	AssetsWindow.Base.SetTitle(title)
+}
+
+func ToggleAssetSelection(g *c.Gui, v *c.View) error {
+	asset, ok := AssetsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	if selectedAssets[asset.Id] {
+		delete(selectedAssets, asset.Id)
+	} else {
+		selectedAssets[asset.Id] = true
+	}
+	AssetsWindow.RefreshItems()
+	setAssetsTitle()
+	return nil
+}
+
+// assetsToMaterialize returns the selected assets, or the asset under the cursor when nothing is selected
+func assetsToMaterialize() []s.AssetNode {
+	assets := s.Filter(AssetsWindow.RawElements, func(asset s.AssetNode) bool { return selectedAssets[asset.Id] })
+	if len(assets) == 0 {
+		if asset, ok := AssetsWindow.GetRawElementOnCursorPosition(); ok {
+			assets = append(assets, asset)
+		}
+	}
+	return assets
+}
+
+// assetJobName returns the implicit asset job the asset is part of, dagster creates one per partitions definition
+func assetJobName(asset s.AssetNode) string {
+	for _, jobName := range asset.JobNames {
+		if strings.HasPrefix(jobName, "__ASSET_JOB") {
+			return jobName
+		}
+	}
+	return "__ASSET_JOB"
+}
+
+// MaterializeAssets opens the launch window for a run of the implicit asset job limited to the selected assets
+func MaterializeAssets(g *c.Gui, v *c.View) error {
+	assets := assetsToMaterialize()
+	if len(assets) == 0 {
+		return nil
+	}
+
+	first := assets[0]
+	location, jobName := first.Repository.Location.Name, assetJobName(first)
+	if _, ok := Overview.Repositories[location]; !ok {
+		return OpenErrorWindow(g, fmt.Errorf("Repository %s of asset %s is not loaded", location, first.AssetKey.String()))
+	}
+	target := launchTarget{repo: Overview.GetRepoByLocation(location), jobName: jobName}
+	for _, asset := range assets {
+		if asset.Repository.Location.Name != location || assetJobName(asset) != jobName {
+			return OpenErrorWindow(g, fmt.Errorf("Assets %s and %s can not be materialized in one run, they belong to different code locations or partitions definitions",
+				first.AssetKey.String(), asset.AssetKey.String()))
+		}
+		target.assetSelection = append(target.assetSelection, asset.AssetKey)
+	}
+
+	// the partition field is only shown when the asset job has partitions
+	FetchAsync(g, "asset_partitions", AssetsWindow.Base,
+		func(ctx context.Context) ([]s.PartitionRepresentation, error) {
+			return Client.GetPartitions(ctx, target.repo, jobName)
+		},
+		func(g *c.Gui, partitions []s.PartitionRepresentation) error {
+			// the focus moved on while loading
+			if current := g.CurrentView(); current == nil || current.Name() != ASSETS_VIEW {
+				return nil
+			}
+			for _, partition := range partitions {
+				target.partitions = append(target.partitions, partition.Name)
+			}
+			if err := openLaunchPopup(g, v, target, "", nil); err != nil {
+				return err
+			}
+			title := fmt.Sprintf("Materialize %s", first.AssetKey.String())
+			if len(assets) > 1 {
+				title = fmt.Sprintf("Materialize %d assets", len(assets))
+			}
+			LaunchRunWindow.Base.SetTitle(title)
+			return nil
+		})
+	return nil
+}
+
+func applyMaterializationResult(g *c.Gui, result launchResult) error {
+	returnWindow := REPOSITORIES_VIEW
+	if _, err := g.View(ASSETS_VIEW); err == nil {
+		selectedAssets = make(map[string]bool)
+		LoadAssets(g)
+		returnWindow = ASSETS_VIEW
+	}
+	message := fmt.Sprintf("Launched run %s materializing %d asset(s)", result.runId, len(result.target.assetSelection))
+	return openFeedbackPopup(g, "Materialization Launched", message, returnWindow)
+}
+
+func ReloadAssets(g *c.Gui, v *c.View) error {
+	LoadAssets(g)
+	return nil
+}
+
+func OpenAssetsWindow(g *c.Gui, v *c.View) error {
+	if _, err := g.View(ASSETS_VIEW); err == nil {
+		return nil
+	}
+	maxX, maxY := g.Size()
+
+	// sorted by code location and group first, so the assets of a group are listed together
+	AssetsWindow.Initialize(g, "Assets", ASSETS_VIEW, formatAsset,
+		func(asset s.AssetNode) string {
+			return fmt.Sprintf("%s %s", assetGroup(asset), asset.AssetKey.String())
+		})
+	AssetsWindow.Base.RenderView(g, int(float64(maxX)*0.05), int(float64(maxY)*0.05), int(float64(maxX)*0.95), int(float64(maxY)*0.95))
+	AssetsWindow.Base.SetNavigableFeedback(g)
+	AssetsWindow.Base.View.Wrap = false
+	selectedAssets = make(map[string]bool)
+	LoadAssets(g)
+
+	returnWindow := REPOSITORIES_VIEW
+	if v != nil {
+		returnWindow = v.Name()
+	}
+	return State.SetNewActiveWindow(g, returnWindow, ASSETS_VIEW)
+}
+
+func formatAssetMaterialization(materialization s.AssetMaterialization) string {
+	partition := materialization.Partition
+	if partition == "" {
+		partition = "-"
+	}
+	return fmt.Sprintf("%s  %s  %-20s  %s", formatLogTimestamp(materialization.Timestamp), materialization.RunId, partition, materialization.StepKey)
+}
+
+// OpenAssetHistoryWindow shows the most recent materializations of the asset under the cursor
+func OpenAssetHistoryWindow(g *c.Gui, v *c.View) error {
+	asset, ok := AssetsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	maxX, maxY := g.Size()
+
+	AssetHistoryWindow.Initialize(g, fmt.Sprintf("Materializations of %s", asset.AssetKey.String()), ASSET_HISTORY)
+	AssetHistoryWindow.Base.RenderView(g, int(float64(maxX)*0.15), int(float64(maxY)*0.15), int(float64(maxX)*0.85), int(float64(maxY)*0.85))
+	AssetHistoryWindow.Base.SetNavigableFeedback(g)
+	AssetHistoryWindow.Base.View.Wrap = false
+	AssetHistoryWindow.Base.View.Highlight = true
+
+	FetchAsync(g, ASSET_HISTORY, AssetHistoryWindow.Base,
+		func(ctx context.Context) ([]s.AssetMaterialization, error) {
+			return Client.GetAssetMaterializations(ctx, asset.AssetKey, assetHistoryLimit)
+		},
+		func(g *c.Gui, materializations []s.AssetMaterialization) error {
+			// the history window got closed while loading
+			if _, err := g.View(ASSET_HISTORY); err != nil {
+				return nil
+			}
+			lines := make([]string, 0)
+			for _, materialization := range materializations {
+				lines = append(lines, formatAssetMaterialization(materialization))
+			}
+			if len(lines) == 0 {
+				lines = append(lines, "never materialized")
+			}
+			AssetHistoryWindow.RenderContent(lines)
+			return nil
+		})
+
+	return State.SetNewActiveWindow(g, v.Name(), ASSET_HISTORY)
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+)
+
+// latestRequests holds the id of the most recent request per request key,
+// responses of older requests with the same key are considered stale and dropped
+var latestRequests = make(map[string]int)
+
+// cancelRequests holds the cancel function of the most recent request per request key
+var cancelRequests = make(map[string]context.CancelFunc)
+
+// loadingTargets holds the view showing the loading indicator of the most recent request per request key
+var loadingTargets = make(map[string]*s.BaseView)
+
+// FetchAsync runs fetch outside of the gocui main loop and hands the result to apply via g.Update.
+// The target view shows a loading indicator in its title while the request is running, errors of fetch
+// are shown in the error popup instead of being applied, just like the errors apply returns.
+// Only the most recent request for a given key gets applied, so e.g. the runs of a job the cursor
+// has already left do not overwrite the runs of the currently selected job. The context of an outdated
+// request gets cancelled.
+func FetchAsync[T any](g *c.Gui, key string, target *s.BaseView, fetch func(context.Context) (T, error), apply func(*c.Gui, T) error) {
+	FetchAsyncHandlingErrors(g, key, target, fetch, apply, showAsyncError)
+}
+
+// FetchAsyncHandlingErrors is FetchAsync with the errors of fetch and apply handed to onError instead of being shown
+// in the error popup, e.g. for requests running in the background where a popup would get in the way
+func FetchAsyncHandlingErrors[T any](g *c.Gui, key string, target *s.BaseView, fetch func(context.Context) (T, error), apply func(*c.Gui, T) error, onError func(*c.Gui, error)) {
+	if cancel, ok := cancelRequests[key]; ok {
+		cancel()
+		// the outdated request stops counting as loading right away, its result gets dropped
+		loadingTargets[key].SetLoading(false)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelRequests[key] = cancel
+	loadingTargets[key] = target
+
+	latestRequests[key]++
+	requestId := latestRequests[key]
+	target.SetLoading(true)
+
+	go func() {
+		result, err := fetch(ctx)
+		g.Update(func(g *c.Gui) error {
+			if latestRequests[key] != requestId {
+				return nil
+			}
+			target.SetLoading(false)
+			cancel()
+			delete(cancelRequests, key)
+			delete(loadingTargets, key)
+			if err == nil {
+				err = apply(g, result)
+			}
+			if err != nil {
+				onError(g, err)
+			}
+			return nil
+		})
+	}()
+}
+
+// showAsyncError shows an error of a request or of applying its result in the error popup. Update funcs must
+// not return it since gocui ends its main loop on any error they return. When even the popup can not be opened
+// the error ends up in the status bar
+func showAsyncError(g *c.Gui, err error) {
+	if popupErr := OpenErrorWindow(g, err); popupErr != nil {
+		showStatus(err.Error())
+	}
+}
+
+// MutateAsync is FetchAsync for requests that change something in dagster, like launching a run. Cancelling
+// those would hide their outcome while the change may already have happened, so instead of cancelling the
+// running request a new request with the same key is ignored until the running one has finished.
+func MutateAsync[T any](g *c.Gui, key string, target *s.BaseView, mutate func(context.Context) (T, error), apply func(*c.Gui, T) error) {
+	if requestInFlight(key) {
+		showStatus(fmt.Sprintf("Still waiting for the previous %s request", strings.ReplaceAll(key, "_", " ")))
+		return
+	}
+	FetchAsync(g, key, target, mutate, apply)
+}
+
+// requestInFlight returns whether a request with the key is running
+func requestInFlight(key string) bool {
+	_, running := cancelRequests[key]
+	return running
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"context"
+	s "nl/vdb/dagstertui/internal"
+)
+
+// LaunchBackfill launches a backfill for the partitions of one partition set and returns the id of the backfill
+func (c *GraphQLClient) LaunchBackfill(ctx context.Context, repository s.RepositoryRepresentation, partitionSetName string, partitionNames []string) (string, error) {
+	query := `mutation LaunchBackfillMutation($backfillParams: LaunchBackfillParams!) {
+		launchPartitionBackfill(backfillParams: $backfillParams) {
+			__typename
+			... on LaunchBackfillSuccess {
+			backfillId
+			}
+			... on RunConfigValidationInvalid {
+			errors {
+				...ConfigValidationErrorFragment
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}` + configValidationErrorFragment
+	variables := map[string]any{
+		"backfillParams": map[string]any{
+			"selector": map[string]any{
+				"partitionSetName": partitionSetName,
+				"repositorySelector": map[string]any{
+					"repositoryName":         repository.Name,
+					"repositoryLocationName": repository.Location,
+				},
+			},
+			"partitionNames": partitionNames,
+		},
+	}
+
+	var response s.LaunchBackfillResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return "", err
+	}
+
+	launchBackfill := response.LaunchPartitionBackfill
+	if launchBackfill.TypeName == "RunConfigValidationInvalid" {
+		return "", &RunConfigValidationError{Errors: launchBackfill.Errors}
+	}
+	if err := unionError(launchBackfill.TypeName, launchBackfill.Message, "LaunchBackfillSuccess"); err != nil {
+		return "", err
+	}
+	return launchBackfill.BackfillId, nil
+}
+
+func (c *GraphQLClient) GetBackfills(ctx context.Context, limit int) ([]s.Backfill, error) {
+	query := `query BackfillsQuery($limit: Int) {
+		partitionBackfillsOrError(limit: $limit) {
+			__typename
+			... on PartitionBackfills {
+			results {
+				id
+				status
+				numPartitions
+				numCancelable
+				partitionSetName
+				timestamp
+				partitionStatusCounts {
+					runStatus
+					count
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"limit": limit,
+	}
+
+	var response s.BackfillsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	backfills := response.PartitionBackfillsOrError
+	if err := unionError(backfills.TypeName, backfills.Message, "PartitionBackfills"); err != nil {
+		return nil, err
+	}
+	return backfills.Results, nil
+}
+
+func (c *GraphQLClient) CancelBackfill(ctx context.Context, backfillId string) error {
+	query := `mutation CancelBackfillMutation($backfillId: String!) {
+		cancelPartitionBackfill(backfillId: $backfillId) {
+			__typename
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"backfillId": backfillId,
+	}
+
+	var response s.CancelBackfillResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	result := response.CancelPartitionBackfill
+	return unionError(result.TypeName, result.Message, "CancelBackfillSuccess")
+}
+
+func (c *GraphQLClient) ResumeBackfill(ctx context.Context, backfillId string) error {
+	query := `mutation ResumeBackfillMutation($backfillId: String!) {
+		resumePartitionBackfill(backfillId: $backfillId) {
+			__typename
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"backfillId": backfillId,
+	}
+
+	var response s.ResumeBackfillResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	result := response.ResumePartitionBackfill
+	return unionError(result.TypeName, result.Message, "ResumeBackfillSuccess")
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+)
+
+const backfillsLimit = 50
+
+func formatBackfillStatus(status string) string {
+	padded := fmt.Sprintf("%-18s", status)
+	switch status {
+	case "COMPLETED":
+		return fmt.Sprintf("\x1b[32m%s\x1b[0m", padded)
+	case "FAILED", "CANCELED":
+		return fmt.Sprintf("\x1b[31m%s\x1b[0m", padded)
+	case "REQUESTED", "CANCELING":
+		return fmt.Sprintf("\x1b[33m%s\x1b[0m", padded)
+	default:
+		return padded
+	}
+}
+
+// formatBackfillProgress shows how many partition runs are done out of all partitions, followed by the
+// number of partition runs per status
+func formatBackfillProgress(backfill s.Backfill) string {
+	done := 0
+	counts := ""
+	for _, count := range backfill.PartitionStatusCounts {
+		if isRunFinished(count.RunStatus) {
+			done += count.Count
+		}
+		counts = fmt.Sprintf("%s %s:%d", counts, count.RunStatus, count.Count)
+	}
+	return fmt.Sprintf("%d/%d done \t%s", done, backfill.NumPartitions, counts)
+}
+
+func formatBackfill(backfill s.Backfill) string {
+	return fmt.Sprintf("%s  %s  %s  %s  %s", formatUnixSeconds(backfill.Timestamp), backfill.Id, formatBackfillStatus(backfill.Status), backfill.PartitionSetName, formatBackfillProgress(backfill))
+}
+
+func LoadBackfills(g *c.Gui) {
+	FetchAsync(g, BACKFILLS_VIEW, BackfillsWindow.Base,
+		func(ctx context.Context) ([]s.Backfill, error) {
+			return Client.GetBackfills(ctx, backfillsLimit)
+		},
+		func(g *c.Gui, backfills []s.Backfill) error {
+			// the backfills window got closed while loading
+			if _, err := g.View(BACKFILLS_VIEW); err != nil {
+				return nil
+			}
+			selected, _ := BackfillsWindow.GetRawElementOnCursorPosition()
+			// dagster returns the most recent backfills first
+			BackfillsWindow.RenderItems(backfills, false)
+			if !BackfillsWindow.SelectElement(func(b s.Backfill) bool { return b.Id == selected.Id }) {
+				BackfillsWindow.ResetCursor()
+			}
+			BackfillsWindow.Base.SetTitle(fmt.Sprintf("Backfills (%d)", len(backfills)))
+			return nil
+		})
+}
+
+func ReloadBackfills(g *c.Gui, v *c.View) error {
+	LoadBackfills(g)
+	return nil
+}
+
+func OpenBackfillsWindow(g *c.Gui, v *c.View) error {
+	if _, err := g.View(BACKFILLS_VIEW); err == nil {
+		return nil
+	}
+	maxX, maxY := g.Size()
+
+	BackfillsWindow.Initialize(g, "Backfills", BACKFILLS_VIEW, formatBackfill, func(b s.Backfill) string { return b.Id })
+	BackfillsWindow.Base.RenderView(g, int(float64(maxX)*0.1), int(float64(maxY)*0.1), int(float64(maxX)*0.9), int(float64(maxY)*0.9))
+	BackfillsWindow.Base.SetNavigableFeedback(g)
+	BackfillsWindow.Base.View.Wrap = false
+	LoadBackfills(g)
+
+	returnWindow := REPOSITORIES_VIEW
+	if v != nil {
+		returnWindow = v.Name()
+	}
+	return State.SetNewActiveWindow(g, returnWindow, BACKFILLS_VIEW)
+}
+
+func CancelBackfill(g *c.Gui, v *c.View) error {
+	backfill, ok := BackfillsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	if backfill.NumCancelable == 0 {
+		return OpenErrorWindow(g, fmt.Errorf("Backfill %s has no partitions left to cancel", backfill.Id))
+	}
+	return AskConfirmation(g, v, fmt.Sprintf("Cancel backfill %s?", backfill.Id), func(g *c.Gui) error {
+		MutateAsync(g, "cancel_backfill", BackfillsWindow.Base,
+			func(ctx context.Context) (struct{}, error) {
+				return struct{}{}, Client.CancelBackfill(ctx, backfill.Id)
+			},
+			func(g *c.Gui, _ struct{}) error {
+				LoadBackfills(g)
+				return nil
+			})
+		return nil
+	})
+}
+
+func ResumeBackfill(g *c.Gui, v *c.View) error {
+	backfill, ok := BackfillsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	return AskConfirmation(g, v, fmt.Sprintf("Resume backfill %s?", backfill.Id), func(g *c.Gui) error {
+		MutateAsync(g, "resume_backfill", BackfillsWindow.Base,
+			func(ctx context.Context) (struct{}, error) {
+				return struct{}{}, Client.ResumeBackfill(ctx, backfill.Id)
+			},
+			func(g *c.Gui, _ struct{}) error {
+				LoadBackfills(g)
+				return nil
+			})
+		return nil
+	})
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	s "nl/vdb/dagstertui/internal"
+)
+
+// GetCapturedLogs fetches the stdout and stderr captured under the log key, starting at the cursor
+// (empty for the start), limit is the maximum number of bytes returned
+func (c *GraphQLClient) GetCapturedLogs(ctx context.Context, logKey []string, cursor string, limit int) (s.CapturedLogs, error) {
+	query := `query CapturedLogsQuery($logKey: [String!]!, $cursor: String, $limit: Int) {
+		capturedLogs(logKey: $logKey, cursor: $cursor, limit: $limit) {
+			stdout
+			stderr
+			cursor
+		}
+	}`
+	variables := map[string]any{
+		"logKey": logKey,
+		"cursor": nil,
+		"limit":  limit,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	var response s.CapturedLogsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return s.CapturedLogs{}, err
+	}
+	return response.CapturedLogs, nil
+}
+
+// GetLogFileKey returns the file key the output of the step got captured under, the events of the run are
+// fetched page by page until the LogsCapturedEvent of the step, with only the fields of that event queried.
+// It is empty when the run has no such event
+func (c *GraphQLClient) GetLogFileKey(ctx context.Context, runId string, stepKey string) (string, error) {
+	query := `query LogFileKeyQuery($runId: ID!, $afterCursor: String, $limit: Int) {
+		logsForRun(runId: $runId, afterCursor: $afterCursor, limit: $limit) {
+			__typename
+			... on EventConnection {
+			events {
+				__typename
+				... on LogsCapturedEvent {
+				fileKey
+				stepKeys
+				}
+			}
+			cursor
+			hasMore
+			}
+			... on RunNotFoundError {
+			message
+			}
+			... on PythonError {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"runId":       runId,
+		"afterCursor": nil,
+		"limit":       logsPageSize,
+	}
+
+	for {
+		var response s.LogsForRunResponse
+		if err := c.Execute(ctx, query, variables, &response); err != nil {
+			return "", err
+		}
+		page := response.LogsForRun
+		if err := unionError(page.TypeName, page.Message, "EventConnection"); err != nil {
+			return "", err
+		}
+		for _, event := range page.Events {
+			if event.TypeName != "LogsCapturedEvent" {
+				continue
+			}
+			for _, key := range event.StepKeys {
+				if key == stepKey {
+					return event.FileKey, nil
+				}
+			}
+		}
+		if !page.HasMore || len(page.Events) == 0 || page.Cursor == "" {
+			return "", nil
+		}
+		variables["afterCursor"] = page.Cursor
+	}
+}
+
+// GetComputeLogs fetches the complete stdout and stderr of a step, for dagster versions without captured logs
+func (c *GraphQLClient) GetComputeLogs(ctx context.Context, runId string, stepKey string) (s.CapturedLogs, error) {
+	query := `query ComputeLogsQuery($runId: ID!, $stepKey: String!) {
+		computeLogs(runId: $runId, stepKey: $stepKey) {
+			stdout {
+				data
+			}
+			stderr {
+				data
+			}
+		}
+	}`
+	variables := map[string]any{
+		"runId":   runId,
+		"stepKey": stepKey,
+	}
+
+	var response s.ComputeLogsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return s.CapturedLogs{}, err
+	}
+
+	logs := s.CapturedLogs{}
+	if response.ComputeLogs.Stdout != nil {
+		logs.Stdout = response.ComputeLogs.Stdout.Data
+	}
+	if response.ComputeLogs.Stderr != nil {
+		logs.Stderr = response.ComputeLogs.Stderr.Data
+	}
+	return logs, nil
+}
@@ -0,0 +1,303 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+	"time"
+)
+
+// maximum number of bytes of stdout and stderr fetched per page
+const computeLogsPageSize = 64 * 1024
+
+// computeLogsState keeps track of the step shown in COMPUTE_LOGS_VIEW and the output fetched so far.
+// Like logsFollowState it is only touched from the gocui main loop
+type computeLogsState struct {
+	runId   string
+	stepKey string
+	// nil when the dagster instance has no captured logs, the complete output is fetched with computeLogs then
+	logKey      []string
+	stdout      string
+	stderr      string
+	cursor      string
+	pages       int
+	stderrShown bool
+	stop        chan struct{}
+}
+
+var computeLogs = &computeLogsState{}
+
+// computeLogsPage is the output fetched by a single request
+type computeLogsPage struct {
+	logKey []string
+	logs   s.CapturedLogs
+}
+
+func setComputeLogsTitle() {
+	stream := "stdout"
+	if computeLogs.stderrShown {
+		stream = "stderr"
+	}
+	title := fmt.Sprintf("%s of %s - %s", stream, computeLogs.stepKey, computeLogs.runId)
+	if computeLogs.logKey != nil {
+		title = fmt.Sprintf("%s - %d page(s)", title, computeLogs.pages)
+	}
+	if computeLogs.stop != nil {
+		title = fmt.Sprintf("%s (following)", title)
+	}
+	ComputeLogsWindow.Base.SetTitle(title)
+}
+
+func renderComputeLogs() {
+	output := computeLogs.stdout
+	if computeLogs.stderrShown {
+		output = computeLogs.stderr
+	}
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if output == "" {
+		lines = []string{"<no output>"}
+	}
+	ComputeLogsWindow.RenderContent(lines)
+	setComputeLogsTitle()
+}
+
+// applyComputeLogsPage adds the fetched output to the output shown, the complete output replaces it
+// when paging is not supported
+func applyComputeLogsPage(page computeLogsPage) {
+	computeLogs.logKey = page.logKey
+	if page.logKey == nil {
+		computeLogs.stdout, computeLogs.stderr = page.logs.Stdout, page.logs.Stderr
+	} else {
+		computeLogs.stdout += page.logs.Stdout
+		computeLogs.stderr += page.logs.Stderr
+		if page.logs.Cursor != "" {
+			computeLogs.cursor = page.logs.Cursor
+		}
+		computeLogs.pages++
+	}
+	renderComputeLogs()
+}
+
+// computeLogKey finds the file key the output of the step got captured under, steps executed in the same
+// process share a file key
+func computeLogKey(ctx context.Context, runId string, stepKey string) ([]string, error) {
+	fileKey, err := Client.GetLogFileKey(ctx, runId, stepKey)
+	if err != nil {
+		return nil, err
+	}
+	if fileKey == "" {
+		fileKey = stepKey
+	}
+	return []string{runId, "compute_logs", fileKey}, nil
+}
+
+// fetchComputeLogs fetches the output after the cursor, falling back to computeLogs for dagster versions
+// that don't know capturedLogs
+func fetchComputeLogs(ctx context.Context, runId string, stepKey string, logKey []string, cursor string) (computeLogsPage, error) {
+	if logKey != nil {
+		logs, err := Client.GetCapturedLogs(ctx, logKey, cursor, computeLogsPageSize)
+		if !unknownField(err, "capturedLogs") {
+			return computeLogsPage{logKey, logs}, err
+		}
+	}
+	logs, err := Client.GetComputeLogs(ctx, runId, stepKey)
+	return computeLogsPage{nil, logs}, err
+}
+
+func startFollowingComputeLogs(g *c.Gui) {
+	if computeLogs.stop != nil {
+		return
+	}
+	stop := make(chan struct{})
+	computeLogs.stop = stop
+	setComputeLogsTitle()
+
+	go pollComputeLogs(g, computeLogs.runId, computeLogs.stepKey, computeLogs.logKey, computeLogs.cursor, stop)
+}
+
+func stopFollowingComputeLogs() {
+	if computeLogs.stop == nil {
+		return
+	}
+	close(computeLogs.stop)
+	computeLogs.stop = nil
+}
+
+// stepInProgress returns whether the step of the run is still running
+func stepInProgress(ctx context.Context, runId string, stepKey string) (bool, error) {
+	steps, err := Client.GetStepStats(ctx, runId)
+	if err != nil {
+		return false, err
+	}
+	for _, step := range steps {
+		if step.StepKey == stepKey {
+			return step.Status == "IN_PROGRESS", nil
+		}
+	}
+	return false, nil
+}
+
+// pollComputeLogs fetches new output every logsPollInterval until stop is closed or the step has finished
+func pollComputeLogs(g *c.Gui, runId string, stepKey string, logKey []string, cursor string, stop chan struct{}) {
+	ticker := time.NewTicker(logsPollInterval)
+	defer ticker.Stop()
+
+	// cancel a running request as soon as following gets stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		page, err := fetchComputeLogs(ctx, runId, stepKey, logKey, cursor)
+		finished := false
+		if err == nil && (page.logKey == nil || page.logs.Stdout == "" && page.logs.Stderr == "") {
+			// only check whether the step is done when it has stopped writing output, the complete output
+			// gives no hint so the step gets checked on every poll
+			var running bool
+			running, err = stepInProgress(ctx, runId, stepKey)
+			finished = !running
+		}
+		if err != nil {
+			g.Update(func(g *c.Gui) error {
+				if computeLogs.stop != stop {
+					return nil
+				}
+				stopFollowingComputeLogs()
+				setComputeLogsTitle()
+				showAsyncError(g, err)
+				return nil
+			})
+			return
+		}
+		if page.logKey != nil && page.logs.Cursor != "" {
+			cursor = page.logs.Cursor
+		}
+
+		g.Update(func(g *c.Gui) error {
+			// the window got closed or following got toggled in the meantime
+			if computeLogs.stop != stop {
+				return nil
+			}
+			if _, err := g.View(COMPUTE_LOGS_VIEW); err != nil {
+				return nil
+			}
+			if page.logs.Stdout != "" || page.logs.Stderr != "" || page.logKey == nil {
+				applyComputeLogsPage(page)
+				ScrollToBottom(ComputeLogsWindow.Base.View)
+			}
+			if finished {
+				stopFollowingComputeLogs()
+				setComputeLogsTitle()
+			}
+			return nil
+		})
+
+		if finished {
+			return
+		}
+	}
+}
+
+func ToggleFollowComputeLogs(g *c.Gui, v *c.View) error {
+	if computeLogs.stop != nil {
+		stopFollowingComputeLogs()
+		setComputeLogsTitle()
+		return nil
+	}
+	startFollowingComputeLogs(g)
+	return ScrollToBottom(ComputeLogsWindow.Base.View)
+}
+
+// ToggleComputeLogStream switches between stdout and stderr
+func ToggleComputeLogStream(g *c.Gui, v *c.View) error {
+	computeLogs.stderrShown = !computeLogs.stderrShown
+	renderComputeLogs()
+	ComputeLogsWindow.Base.View.SetOrigin(0, 0)
+	ComputeLogsWindow.Base.View.SetCursor(0, 0)
+	return nil
+}
+
+// LoadNextComputeLogsPage fetches the output after the cursor and appends it
+func LoadNextComputeLogsPage(g *c.Gui, v *c.View) error {
+	if computeLogs.stop != nil {
+		return nil
+	}
+	runId, stepKey, logKey, cursor := computeLogs.runId, computeLogs.stepKey, computeLogs.logKey, computeLogs.cursor
+	FetchAsync(g, COMPUTE_LOGS_VIEW, ComputeLogsWindow.Base,
+		func(ctx context.Context) (computeLogsPage, error) {
+			return fetchComputeLogs(ctx, runId, stepKey, logKey, cursor)
+		},
+		func(g *c.Gui, page computeLogsPage) error {
+			if _, err := g.View(COMPUTE_LOGS_VIEW); err != nil {
+				return nil
+			}
+			if page.logKey != nil && page.logs.Stdout == "" && page.logs.Stderr == "" {
+				return nil
+			}
+			applyComputeLogsPage(page)
+			return nil
+		})
+	return nil
+}
+
+func CloseComputeLogsWindow(g *c.Gui, v *c.View) error {
+	stopFollowingComputeLogs()
+	return ClosePopupView(g, v)
+}
+
+// OpenComputeLogsWindow shows the stdout and stderr of the step under the cursor in STEPS_VIEW
+func OpenComputeLogsWindow(g *c.Gui, v *c.View) error {
+	step, ok := StepsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	maxX, maxY := g.Size()
+	runId := stepsRun.RunId
+
+	stopFollowingComputeLogs()
+	*computeLogs = computeLogsState{runId: runId, stepKey: step.StepKey}
+
+	ComputeLogsWindow.Initialize(g, "", COMPUTE_LOGS_VIEW)
+	ComputeLogsWindow.Base.RenderView(g, int(float64(maxX)*0.05), int(float64(maxY)*0.05), int(float64(maxX)*0.95), int(float64(maxY)*0.95))
+	ComputeLogsWindow.Base.SetNavigableFeedback(g)
+	ComputeLogsWindow.Base.View.Wrap = false
+	ComputeLogsWindow.Base.View.Highlight = true
+	setComputeLogsTitle()
+
+	FetchAsync(g, COMPUTE_LOGS_VIEW, ComputeLogsWindow.Base,
+		func(ctx context.Context) (computeLogsPage, error) {
+			logKey, err := computeLogKey(ctx, runId, step.StepKey)
+			if err != nil {
+				return computeLogsPage{}, err
+			}
+			return fetchComputeLogs(ctx, runId, step.StepKey, logKey, "")
+		},
+		func(g *c.Gui, page computeLogsPage) error {
+			// the window got closed while loading
+			if _, err := g.View(COMPUTE_LOGS_VIEW); err != nil {
+				return nil
+			}
+			applyComputeLogsPage(page)
+			if step.Status == "IN_PROGRESS" {
+				startFollowingComputeLogs(g)
+				return ScrollToBottom(ComputeLogsWindow.Base.View)
+			}
+			return nil
+		})
+
+	return State.SetNewActiveWindow(g, v.Name(), COMPUTE_LOGS_VIEW)
+}
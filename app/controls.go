@@ -71,7 +71,6 @@ func CursorUp(g *c.Gui, v *c.View) error {
 	return nil
 }
 
-
 func SetWindowColors(g *c.Gui, viewName string, bgColor string) error {
 	view, err := g.View(viewName)
 	if err != nil {
@@ -94,7 +93,7 @@ func SetFocus(g *c.Gui, newViewName string, oldViewName string) error {
 	if err != nil {
 		return err
 	}
-	
+
 	// Set background color of active window to red, and background color of inactive windows to default
 	if err := SetWindowColors(g, newViewName, "red"); err != nil {
 		return err
@@ -163,3 +162,43 @@ func SwitchFocusLeft(g *c.Gui, v *c.View) error {
 
 	return SetFocus(g, previousViewName, currentViewName)
 }
+
+func PageDown(g *c.Gui, v *c.View) error {
+	_, h := v.Size()
+	ox, oy := v.Origin()
+	lines := len(v.BufferLines())
+	if oy+h >= lines {
+		return nil
+	}
+	newOy := oy + h
+	if newOy > lines-h {
+		newOy = lines - h
+	}
+	return v.SetOrigin(ox, newOy)
+}
+
+func PageUp(g *c.Gui, v *c.View) error {
+	_, h := v.Size()
+	ox, oy := v.Origin()
+	newOy := oy - h
+	if newOy < 0 {
+		newOy = 0
+	}
+	return v.SetOrigin(ox, newOy)
+}
+
+func ScrollToBottom(v *c.View) error {
+	_, h := v.Size()
+	// the buffer ends with an empty line after the last Fprintln
+	lines := len(v.BufferLines()) - 1
+	if lines <= 0 {
+		return nil
+	}
+	if lines > h {
+		if err := v.SetOrigin(0, lines-h); err != nil {
+			return err
+		}
+		return v.SetCursor(0, h-1)
+	}
+	return v.SetCursor(0, lines-1)
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	c "github.com/jroimartin/gocui"
+	"regexp"
+	"strings"
+)
+
+// unlessEditing wraps the handler of a global keybinding, so that the key reaches the editor
+// of an editable view (like the launch window) instead of triggering the handler
+func unlessEditing(key c.Key, ch rune, handler func(*c.Gui, *c.View) error) func(*c.Gui, *c.View) error {
+	return func(g *c.Gui, v *c.View) error {
+		if v != nil && v.Editable && v.Editor != nil {
+			v.Editor.Edit(v, key, ch, c.ModNone)
+			return nil
+		}
+		return handler(g, v)
+	}
+}
+
+var DefaultEditor c.Editor = c.EditorFunc(simpleEditor)
+var FilterEditor c.Editor = c.EditorFunc(filterEditor)
+
+func filterEditor(v *c.View, key c.Key, ch rune, mod c.Modifier) {
+	switch {
+	case ch != 0 && mod == 0:
+		v.EditWrite(ch)
+	case key == c.KeyBackspace || key == c.KeyBackspace2:
+		v.EditDelete(true)
+	}
+	FilterItemsInView(v)
+}
+
+func simpleEditor(v *c.View, key c.Key, ch rune, mod c.Modifier) {
+	switch {
+	case key == c.KeyArrowDown:
+		v.MoveCursor(0, 1, false)
+	case key == c.KeyArrowUp:
+		v.MoveCursor(0, -1, false)
+	case key == c.KeyArrowLeft:
+		v.MoveCursor(-1, 0, false)
+	case key == c.KeyArrowRight:
+		v.MoveCursor(1, 0, false)
+	case key == c.KeyCtrlSlash:
+		x, y := v.Cursor()
+		currentLine, _ := v.Line(y)
+
+		re := regexp.MustCompile(`^[\s]*#`) // any amount of whitespaces followed by # at the beginning of a line
+		if re.MatchString(currentLine) {
+			index := strings.Index(currentLine, "#")
+			v.SetCursor(index, y)
+			v.EditDelete(false)
+		} else {
+			v.SetCursor(0, y)
+			v.EditWrite('#')
+		}
+		v.SetCursor(x, y+1)
+	case key == c.KeySpace:
+		v.EditWrite(' ')
+	case key == c.KeyEnter:
+		// the run config and the tags span multiple lines
+		v.EditNewLine()
+	case key == c.KeyBackspace || key == c.KeyBackspace2:
+		v.EditDelete(true)
+	case ch != 0 && mod == 0:
+		v.EditWrite(ch)
+	}
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+)
+
+// TransportError is returned when a request could not be sent to dagster or its response could not be read
+type TransportError struct {
+	Url string
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("Request to %s failed: %v", e.Url, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// HTTPStatusError is returned when dagster answers with a non 2xx status code
+type HTTPStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("Dagster responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// DecodeError is returned when the response of dagster is not the JSON we expected
+type DecodeError struct {
+	Err  error
+	Body string
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("Failed to parse response: %v\n%s", e.Err, e.Body)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// GraphQLError is returned when the response contains an errors array, e.g. for a query the dagster version does not support
+type GraphQLError struct {
+	Messages []string
+}
+
+func (e *GraphQLError) Error() string {
+	return fmt.Sprintf("GraphQL errors:\n%s", strings.Join(e.Messages, "\n"))
+}
+
+// unknownField returns whether err is a GraphQLError about a field the queried type does not have,
+// which is how older dagster versions answer queries for newer fields
+func unknownField(err error, field string) bool {
+	var graphQLError *GraphQLError
+	if !errors.As(err, &graphQLError) {
+		return false
+	}
+	for _, message := range graphQLError.Messages {
+		if strings.Contains(message, fmt.Sprintf("Cannot query field \"%s\"", field)) {
+			return true
+		}
+	}
+	return false
+}
+
+// DagsterError is returned when dagster answers with one of the error types of a union,
+// like PythonError, PipelineNotFoundError or RepositoryNotFoundError
+type DagsterError struct {
+	TypeName string
+	Message  string
+}
+
+func (e *DagsterError) Error() string {
+	if e.Message == "" {
+		return e.TypeName
+	}
+	return fmt.Sprintf("%s: %s", e.TypeName, e.Message)
+}
+
+// unionError returns a DagsterError unless typeName is one of the expected (non error) types of the union
+func unionError(typeName string, message string, expected ...string) error {
+	for _, e := range expected {
+		if typeName == e {
+			return nil
+		}
+	}
+	return &DagsterError{TypeName: typeName, Message: message}
+}
+
+// RunConfigValidationError is returned when dagster rejects the run config of a run
+type RunConfigValidationError struct {
+	Errors []s.ConfigValidationError
+}
+
+func (e *RunConfigValidationError) Error() string {
+	return fmt.Sprintf("Invalid run config:\n%s", strings.Join(FormatConfigValidationErrors(e.Errors), "\n"))
+}
+
+// ConfigPath returns the path in the run config an error refers to, e.g. ops.my_op.config.date
+func ConfigPath(err s.ConfigValidationError) []string {
+	path := make([]string, 0)
+	for _, entry := range err.Stack.Entries {
+		switch entry.TypeName {
+		case "EvaluationStackPathEntry":
+			path = append(path, entry.FieldName)
+		case "EvaluationStackListItemEntry":
+			path = append(path, fmt.Sprintf("[%d]", entry.ListIndex))
+		case "EvaluationStackMapKeyEntry", "EvaluationStackMapValueEntry":
+			path = append(path, fmt.Sprint(entry.MapKey))
+		}
+	}
+	return path
+}
+
+func FormatConfigValidationErrors(errs []s.ConfigValidationError) []string {
+	lines := make([]string, 0)
+	for _, err := range errs {
+		line := fmt.Sprintf("[%s] %s", err.Reason, err.Message)
+		if path := ConfigPath(err); len(path) > 0 {
+			line = fmt.Sprintf("%s (at %s)", line, strings.Join(path, "."))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
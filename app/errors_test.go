@@ -0,0 +1,35 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestUnknownField(t *testing.T) {
+	// the message graphql-core answers with when a dagster version does not know capturedLogs
+	unknownCapturedLogs := `Cannot query field "capturedLogs" on type "Query".`
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"message of graphql-core", &GraphQLError{Messages: []string{unknownCapturedLogs}}, true},
+		{"message with a suggestion", &GraphQLError{Messages: []string{`Cannot query field "capturedLogs" on type "Query". Did you mean "computeLogs"?`}}, true},
+		{"one of several messages", &GraphQLError{Messages: []string{"Variable \"$limit\" is never used.", unknownCapturedLogs}}, true},
+		{"wrapped", fmt.Errorf("fetching the logs: %w", &GraphQLError{Messages: []string{unknownCapturedLogs}}), true},
+		{"another field", &GraphQLError{Messages: []string{`Cannot query field "capturedLogsMetadata" on type "Query".`}}, false},
+		{"another graphql error", &GraphQLError{Messages: []string{`Unknown argument "cursor" on field "capturedLogs" of type "Query".`}}, false},
+		{"not a graphql error", &HTTPStatusError{StatusCode: 400, Body: unknownCapturedLogs}, false},
+		{"plain error", errors.New(unknownCapturedLogs), false},
+		{"no error", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := unknownField(test.err, "capturedLogs"); got != test.want {
+				t.Errorf("unknownField(%v) = %t, want %t", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	"github.com/nsf/termbox-go"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// externalEditor returns the command of the editor the user configured, $VISUAL before $EDITOR
+func externalEditor() []string {
+	for _, variable := range []string{"VISUAL", "EDITOR"} {
+		if command := strings.Fields(os.Getenv(variable)); len(command) > 0 {
+			return command
+		}
+	}
+	return nil
+}
+
+// GuiOutputMode is the output mode the gui gets created with, gocui keeps its own copy to itself
+var GuiOutputMode = c.Output256
+
+// runSuspended hands the terminal over to the command until it exits, gocui is suspended in the meantime
+// and gets the terminal back in the same input and output mode.
+// The main loop of gocui keeps waiting in termbox.PollEvent meanwhile. Input only reaches it again once termbox
+// is initialized, but a resize would make it read the size of the closed terminal, so resizes are not
+// delivered to termbox until it is initialized again
+func runSuspended(g *c.Gui, command []string) error {
+	stopResizeEvents()
+	termbox.Close()
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	runErr := cmd.Run()
+
+	if err := termbox.Init(); err != nil {
+		return err
+	}
+	inputMode := termbox.InputAlt
+	if g.InputEsc {
+		inputMode = termbox.InputEsc
+	}
+	if g.Mouse {
+		inputMode |= termbox.InputMouse
+	}
+	termbox.SetInputMode(inputMode)
+	termbox.SetOutputMode(termbox.OutputMode(GuiOutputMode))
+	// the terminal may have been resized while the command ran, the next flush picks up its size and
+	// lays out all views again
+	g.Update(func(g *c.Gui) error { return nil })
+	return runErr
+}
+
+// EditRunConfigExternally opens the run config of the launch window in $VISUAL or $EDITOR and loads the
+// edited config back once the editor exits
+func EditRunConfigExternally(g *c.Gui, v *c.View) error {
+	command := externalEditor()
+	if command == nil {
+		return showLaunchFeedback(g, "Errors", []string{"Set $VISUAL or $EDITOR to edit the run config in an external editor"}, c.ColorRed)
+	}
+
+	file, err := os.CreateTemp("", "dagstertui-run-config-*.yaml")
+	if err != nil {
+		return OpenErrorWindow(g, err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(strings.Join(runConfigLines(), "\n") + "\n")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return OpenErrorWindow(g, err)
+	}
+
+	if err := runSuspended(g, append(command, file.Name())); err != nil {
+		return OpenErrorWindow(g, fmt.Errorf("Editing the run config with %s failed: %w", command[0], err))
+	}
+
+	content, err := os.ReadFile(file.Name())
+	if err != nil {
+		return OpenErrorWindow(g, err)
+	}
+	// validation errors point to lines of the previous config
+	if err := clearLaunchErrors(g); err != nil {
+		return err
+	}
+	LaunchRunWindow.RenderContent(strings.Split(strings.TrimRight(string(content), "\n"), "\n"))
+	moveEditorCursorTo(LaunchRunWindow.Base.View, 0)
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package app
+
+import (
+	"os/signal"
+	"syscall"
+)
+
+// stopResizeEvents stops the resize signals from reaching termbox, termbox.Init registers for them again
+func stopResizeEvents() {
+	signal.Reset(syscall.SIGWINCH)
+}
@@ -0,0 +1,4 @@
+package app
+
+// stopResizeEvents does nothing on windows, termbox reads resizes from the console input there
+func stopResizeEvents() {}
@@ -1,4 +1,3 @@
-
 package app
 
 import (
@@ -33,42 +32,112 @@ func Layout(g *c.Gui) error {
 	// TODO ok for now, but could be more content-agnostic
 	EnvironmentInfoView.Base.RenderView(g, window3X+windowWidth-len(Overview.Url)-1, 0, int(float64(window3X+windowWidth)), yOffset-1)
 
+	// between FILTER_VIEW and ENVIRONMENT_INFO
+	StatusView.Base.RenderView(g, window1X+windowWidth/2+1, 0, window3X+windowWidth-len(Overview.Url)-2, yOffset-1)
+
 	// on top of REPOSITORIES_VIEW
 	FilterView.Base.RenderView(g, 0, 0, window1X+windowWidth/2, yOffset-1)
 
 	return nil
 }
 
+// mainWindows are the windows that are always shown, the popups are opened on top of them
+var mainWindows = []string{REPOSITORIES_VIEW, JOBS_VIEW, RUNS_VIEW}
+
 func SetKeybindings(g *c.Gui) error {
 	// Set keybindings to switch focus between windows
-	if err := g.SetKeybinding("", c.KeyArrowRight, c.ModNone, SwitchFocusRight); err != nil {
+	if err := g.SetKeybinding("", c.KeyArrowRight, c.ModNone, unlessEditing(c.KeyArrowRight, 0, SwitchFocusRight)); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding("", c.KeyArrowLeft, c.ModNone, SwitchFocusLeft); err != nil {
+	if err := g.SetKeybinding("", c.KeyArrowLeft, c.ModNone, unlessEditing(c.KeyArrowLeft, 0, SwitchFocusLeft)); err != nil {
 		return err
 	}
 
 	// Quit
-	if err := g.SetKeybinding("", 'q', c.ModNone, Quit); err != nil {
+	if err := g.SetKeybinding("", 'q', c.ModNone, unlessEditing(0, 'q', Quit)); err != nil {
 		return err
 	}
 	// Open Controls window
-	if err := g.SetKeybinding("", 'x', c.ModNone, OpenPopupKeyMaps); err != nil {
+	if err := g.SetKeybinding("", 'x', c.ModNone, unlessEditing(0, 'x', OpenPopupKeyMaps)); err != nil {
 		return err
 	}
 
-	if err := g.SetKeybinding("", 'O', c.ModNone, OpenInBrowser); err != nil {
+	if err := g.SetKeybinding("", 'O', c.ModNone, unlessEditing(0, 'O', OpenInBrowser)); err != nil {
 		return err
 	}
+	// popups that can be opened from anywhere are bound to the main windows only, opening them on top of
+	// another popup would mix up the windows the popups return to
+	for _, view := range mainWindows {
+		if err := g.SetKeybinding(view, 'B', c.ModNone, OpenBackfillsWindow); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, 'A', c.ModNone, OpenAssetsWindow); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, 'F', c.ModNone, OpenRunsSearch); err != nil {
+			return err
+		}
+	}
 	if err := g.SetKeybinding(KEY_MAPPINGS_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(LAUNCH_RUN_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+	if err := g.SetKeybinding(LAUNCH_RUN_VIEW, c.KeyEsc, c.ModNone, CloseLaunchWindow); err != nil {
 		return err
 	}
 	if err := g.SetKeybinding(LAUNCH_RUN_VIEW, c.KeyCtrlL, c.ModNone, ValidateAndLaunchRun); err != nil {
 		return err
 	}
+	if err := g.SetKeybinding(LAUNCH_RUN_VIEW, c.KeyCtrlV, c.ModNone, ValidateRunConfig); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_RUN_VIEW, c.KeyCtrlE, c.ModNone, EditRunConfigExternally); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_RUN_VIEW, c.KeyTab, c.ModNone, SwitchLaunchPane); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_NAME_VIEW, c.KeyEsc, c.ModNone, CloseLaunchWindow); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_NAME_VIEW, c.KeyCtrlL, c.ModNone, ValidateAndLaunchRun); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_NAME_VIEW, c.KeyCtrlV, c.ModNone, ValidateRunConfig); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_NAME_VIEW, c.KeyEnter, c.ModNone, SwitchLaunchPane); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_NAME_VIEW, c.KeyTab, c.ModNone, SwitchLaunchPane); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_PARTITION, c.KeyEsc, c.ModNone, CloseLaunchWindow); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_PARTITION, c.KeyCtrlL, c.ModNone, ValidateAndLaunchRun); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_PARTITION, c.KeyCtrlV, c.ModNone, ValidateRunConfig); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_PARTITION, c.KeyEnter, c.ModNone, SwitchLaunchPane); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_PARTITION, c.KeyTab, c.ModNone, SwitchLaunchPane); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_TAGS_VIEW, c.KeyEsc, c.ModNone, CloseLaunchWindow); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_TAGS_VIEW, c.KeyCtrlL, c.ModNone, ValidateAndLaunchRun); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_TAGS_VIEW, c.KeyCtrlV, c.ModNone, ValidateRunConfig); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LAUNCH_TAGS_VIEW, c.KeyTab, c.ModNone, SwitchLaunchPane); err != nil {
+		return err
+	}
 
 	// define keybindings for moving between items
 	if err := g.SetKeybinding(REPOSITORIES_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
@@ -83,6 +152,12 @@ func SetKeybindings(g *c.Gui) error {
 	if err := g.SetKeybinding(REPOSITORIES_VIEW, 'f', c.ModNone, SwitchToFilterView); err != nil {
 		panic(err)
 	}
+	if err := g.SetKeybinding(REPOSITORIES_VIEW, 's', c.ModNone, OpenSchedulesWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(REPOSITORIES_VIEW, 'n', c.ModNone, OpenSensorsWindow); err != nil {
+		panic(err)
+	}
 
 	if err := g.SetKeybinding(JOBS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
 		panic(err)
@@ -96,6 +171,15 @@ func SetKeybindings(g *c.Gui) error {
 	if err := g.SetKeybinding(JOBS_VIEW, 'l', c.ModNone, OpenPopupLaunchWindow); err != nil {
 		panic(err)
 	}
+	if err := g.SetKeybinding(JOBS_VIEW, 'p', c.ModNone, OpenPartitionPicker); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(JOBS_VIEW, 's', c.ModNone, OpenSchedulesWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(JOBS_VIEW, 'n', c.ModNone, OpenSensorsWindow); err != nil {
+		panic(err)
+	}
 
 	if err := g.SetKeybinding(RUNS_VIEW, c.KeyArrowDown, c.ModNone, CursorDownAndUpdateRunInfo); err != nil {
 		panic(err)
@@ -112,6 +196,283 @@ func SetKeybindings(g *c.Gui) error {
 	if err := g.SetKeybinding(RUNS_VIEW, 'T', c.ModNone, TerminateRunByRunId); err != nil {
 		panic(err)
 	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'g', c.ModNone, OpenLogsWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'p', c.ModNone, OpenPartitionPicker); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'o', c.ModNone, OpenStepsWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'r', c.ModNone, ReexecuteFromFailure); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'w', c.ModNone, ToggleWatchRun); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'd', c.ModNone, ToggleRunDiffMark); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_VIEW, 'R', c.ModNone, ReexecuteAllSteps); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyEnter, c.ModNone, LaunchPartition); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyCtrlA, c.ModNone, SelectAllPartitions); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyCtrlR, c.ModNone, SelectPartitionRange); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(PARTITIONS_VIEW, c.KeyCtrlB, c.ModNone, LaunchPartitionBackfill); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(BACKFILLS_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(BACKFILLS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(BACKFILLS_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(BACKFILLS_VIEW, 'c', c.ModNone, CancelBackfill); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(BACKFILLS_VIEW, 'r', c.ModNone, ResumeBackfill); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(BACKFILLS_VIEW, 'u', c.ModNone, ReloadBackfills); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(SCHEDULES_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(SCHEDULES_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SCHEDULES_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SCHEDULES_VIEW, 's', c.ModNone, StartSchedule); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SCHEDULES_VIEW, 'S', c.ModNone, StopSchedule); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SCHEDULES_VIEW, 'u', c.ModNone, ReloadSchedules); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(SENSORS_VIEW, c.KeyEsc, c.ModNone, CloseSensorsWindow); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(SENSORS_VIEW, c.KeyArrowDown, c.ModNone, CursorDownAndUpdateSensorTicks); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SENSORS_VIEW, c.KeyArrowUp, c.ModNone, CursorUpAndUpdateSensorTicks); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SENSORS_VIEW, 's', c.ModNone, StartSensor); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SENSORS_VIEW, 'S', c.ModNone, StopSensor); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SENSORS_VIEW, 'c', c.ModNone, ShowSensorCursor); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SENSORS_VIEW, 'u', c.ModNone, ReloadSensors); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeyPgdn, c.ModNone, PageDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeyPgup, c.ModNone, PageUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeyEnter, c.ModNone, OpenAssetHistoryWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, 'u', c.ModNone, ReloadAssets); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, c.KeySpace, c.ModNone, ToggleAssetSelection); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, 'm', c.ModNone, MaterializeAssets); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSETS_VIEW, 'l', c.ModNone, OpenAssetLineageWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(LINEAGE_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LINEAGE_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(LINEAGE_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSET_HISTORY, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(ASSET_HISTORY, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(ASSET_HISTORY, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(STEPS_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, 'u', c.ModNone, ReloadSteps); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, c.KeySpace, c.ModNone, ToggleStepSelection); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, 'r', c.ModNone, ReexecuteSelectedSteps); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, '/', c.ModNone, OpenStepSelectionQuery); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEPS_VIEW, 'g', c.ModNone, OpenComputeLogsWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(STEP_QUERY_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(STEP_QUERY_VIEW, c.KeyEnter, c.ModNone, ReexecuteStepSelectionQuery); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(RUN_DIFF_VIEW, c.KeyEsc, c.ModNone, CloseRunDiffWindow); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUN_DIFF_VIEW, 's', c.ModNone, ToggleRunDiffLayout); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUN_DIFF_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUN_DIFF_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUN_DIFF_VIEW, c.KeyPgdn, c.ModNone, PageDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUN_DIFF_VIEW, c.KeyPgup, c.ModNone, PageUp); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, c.KeyEsc, c.ModNone, CloseComputeLogsWindow); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, 'f', c.ModNone, ToggleFollowComputeLogs); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, 's', c.ModNone, ToggleComputeLogStream); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, 'n', c.ModNone, LoadNextComputeLogsPage); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, c.KeyPgdn, c.ModNone, PageDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(COMPUTE_LOGS_VIEW, c.KeyPgup, c.ModNone, PageUp); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(SEARCH_FILTER, c.KeyEsc, c.ModNone, CloseRunsSearch); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(SEARCH_FILTER, c.KeyEnter, c.ModNone, SearchRuns); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(SEARCH_FILTER, c.KeyTab, c.ModNone, SwitchRunsSearchPane); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, c.KeyEsc, c.ModNone, CloseRunsSearch); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, c.KeyTab, c.ModNone, SwitchRunsSearchPane); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, c.KeyPgdn, c.ModNone, PageDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, c.KeyPgup, c.ModNone, PageUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(RUNS_SEARCH_VIEW, 'n', c.ModNone, LoadMoreSearchedRuns); err != nil {
+		panic(err)
+	}
+
+	if err := g.SetKeybinding(LOGS_VIEW, c.KeyEsc, c.ModNone, CloseLogsWindow); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(LOGS_VIEW, 'f', c.ModNone, ToggleFollowLogs); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(LOGS_VIEW, c.KeyArrowDown, c.ModNone, CursorDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(LOGS_VIEW, c.KeyArrowUp, c.ModNone, CursorUp); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(LOGS_VIEW, c.KeyPgdn, c.ModNone, PageDown); err != nil {
+		panic(err)
+	}
+	if err := g.SetKeybinding(LOGS_VIEW, c.KeyPgup, c.ModNone, PageUp); err != nil {
+		panic(err)
+	}
 	// if err := g.SetKeybinding(RUNS_VIEW, 'i', c.ModNone, InspectCurrentRunConfig); err != nil {
 	// panic(err)
 	// }
@@ -131,12 +492,12 @@ func SetKeybindings(g *c.Gui) error {
 	if err := g.SetKeybinding(CONFIRMATION_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(FEEDBACK_VIEW, c.KeyEsc, c.ModNone, ClosePopupView); err != nil {
+	if err := g.SetKeybinding(FEEDBACK_VIEW, c.KeyEsc, c.ModNone, CloseFeedbackWindow); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding(CONFIRMATION_VIEW, c.KeyEnter, c.ModNone, TerminateRunWithConfirmationByRunId); err != nil {
+	if err := g.SetKeybinding(CONFIRMATION_VIEW, c.KeyEnter, c.ModNone, ConfirmSelection); err != nil {
 		panic(err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,379 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+)
+
+// launchTarget is what the launch window launches a run of, the assets to materialize are only set
+// when the run is limited to an asset selection and the partitions only when those assets are partitioned
+type launchTarget struct {
+	repo           s.RepositoryRepresentation
+	jobName        string
+	assetSelection []s.AssetKey
+	partitions     []string
+}
+
+// currentLaunch is the target of the open launch window
+var currentLaunch launchTarget
+
+// runNameTag is the tag the custom name of a run launched from the launch window is stored in, dagster itself
+// has no run names
+const runNameTag = "dagstertui/run_name"
+
+// runName returns the custom name of a run, empty if it has none
+func runName(tags []s.Tag) string {
+	for _, tag := range tags {
+		if tag.Key == runNameTag {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
+// withRunName returns the tags with the run name tag set to name, or without it when name is empty
+func withRunName(tags []s.Tag, name string) []s.Tag {
+	return withTag(tags, runNameTag, name)
+}
+
+// withTag returns the tags with the tag key set to value, or without it when value is empty
+func withTag(tags []s.Tag, key string, value string) []s.Tag {
+	result := make([]s.Tag, 0)
+	for _, tag := range tags {
+		if tag.Key != key {
+			result = append(result, tag)
+		}
+	}
+	if value != "" {
+		result = append(result, s.Tag{Key: key, Value: value})
+	}
+	return result
+}
+
+// selectedJobTarget returns the launch target for the selected job
+func selectedJobTarget() launchTarget {
+	return launchTarget{repo: Overview.GetRepoByLocation(State.SelectedRepo), jobName: State.SelectedJob}
+}
+
+// openLaunchPopup opens the run config editor with the run name and the tags pane next to it, a run name tag
+// in tags is shown in the run name field. Partitioned asset materializations get a partition field as well
+func openLaunchPopup(g *c.Gui, v *c.View, target launchTarget, runConfig string, tags []s.Tag) error {
+	maxX, maxY := g.Size()
+	tagsX := int(float64(maxX) * 0.6)
+	currentLaunch = target
+
+	LaunchRunWindow.Initialize(g, fmt.Sprintf("Launch Run For %s", target.jobName), LAUNCH_RUN_VIEW)
+	LaunchRunWindow.Base.RenderView(g, int(float64(maxX)*0.2), int(float64(maxY)*0.2), tagsX, int(float64(maxY)*0.8))
+
+	LaunchRunWindow.Base.View.Editable = true
+	LaunchRunWindow.Base.View.Editor = DefaultEditor
+	LaunchRunWindow.Base.View.Highlight = true
+	LaunchRunWindow.Base.View.SelBgColor = c.ColorBlue
+	LaunchRunWindow.Base.View.SetCursor(0, 0)
+
+	LaunchNameView.Initialize(g, "Run name (optional)", LAUNCH_NAME_VIEW)
+	LaunchNameView.Base.RenderView(g, tagsX+1, int(float64(maxY)*0.2), int(float64(maxX)*0.8), int(float64(maxY)*0.2)+2)
+	LaunchNameView.Base.View.Editable = true
+	LaunchNameView.Base.View.Editor = DefaultEditor
+
+	tagsY := int(float64(maxY)*0.2) + 3
+	if len(target.partitions) > 0 {
+		title := fmt.Sprintf("Partition (optional, latest %s)", target.partitions[len(target.partitions)-1])
+		LaunchPartitionView.Initialize(g, title, LAUNCH_PARTITION)
+		LaunchPartitionView.Base.RenderView(g, tagsX+1, tagsY, int(float64(maxX)*0.8), tagsY+2)
+		LaunchPartitionView.Base.View.Editable = true
+		LaunchPartitionView.Base.View.Editor = DefaultEditor
+		tagsY += 3
+	}
+
+	LaunchTagsView.Initialize(g, "Tags (key=value)", LAUNCH_TAGS_VIEW)
+	LaunchTagsView.Base.RenderView(g, tagsX+1, tagsY, int(float64(maxX)*0.8), int(float64(maxY)*0.8))
+	LaunchTagsView.Base.View.Editable = true
+	LaunchTagsView.Base.View.Editor = DefaultEditor
+	LaunchTagsView.Base.View.Highlight = true
+	LaunchTagsView.Base.View.SelBgColor = c.ColorBlue
+	LaunchTagsView.Base.View.SetCursor(0, 0)
+
+	LaunchRunWindow.RenderContent([]string{runConfig})
+	name := runName(tags)
+	LaunchNameView.RenderContent([]string{name})
+	LaunchNameView.Base.View.SetCursor(len(name), 0)
+	LaunchTagsView.RenderContent(formatTags(withRunName(tags, "")))
+
+	return State.SetNewActiveWindow(g, v.Name(), LAUNCH_RUN_VIEW)
+}
+
+// templateTags returns the tags of a run that should be carried over when it is used as template for a new run,
+// tags dagster sets itself (like the schedule or the parent run) are dropped except for the partition
+func templateTags(tags []s.Tag) []s.Tag {
+	result := make([]s.Tag, 0)
+	for _, tag := range tags {
+		switch {
+		case tag.Key == "dagster/partition" || tag.Key == "dagster/partition_set":
+			result = append(result, tag)
+		case strings.HasPrefix(tag.Key, "dagster/") || strings.HasPrefix(tag.Key, "."):
+			continue
+		default:
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+func formatTags(tags []s.Tag) []string {
+	lines := make([]string, 0)
+	for _, tag := range tags {
+		lines = append(lines, fmt.Sprintf("%s=%s", tag.Key, tag.Value))
+	}
+	return lines
+}
+
+// parseTags reads key=value lines, empty lines and lines starting with # are skipped. A key set twice is an error
+// since dagster would silently keep only one of the values
+func parseTags(lines []string) ([]s.Tag, error) {
+	tags := make([]s.Tag, 0)
+	keyLines := make(map[string]int)
+	for index, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("Tag on line %d is not of the form key=value: %s", index+1, line)
+		}
+		if previous, ok := keyLines[key]; ok {
+			return nil, fmt.Errorf("Tag %s is set on line %d and on line %d", key, previous+1, index+1)
+		}
+		keyLines[key] = index
+		tags = append(tags, s.Tag{Key: key, Value: strings.TrimSpace(value)})
+	}
+	return tags, nil
+}
+
+// launchRunName returns the run name typed into the run name field
+func launchRunName() string {
+	return strings.TrimSpace(strings.Join(LaunchNameView.Base.View.BufferLines(), " "))
+}
+
+// launchPartition returns the partition typed into the partition field, checked against the partitions of the
+// launch target. It is empty when the target has no partitions
+func launchPartition(target launchTarget) (string, error) {
+	if len(target.partitions) == 0 {
+		return "", nil
+	}
+	partition := strings.TrimSpace(strings.Join(LaunchPartitionView.Base.View.BufferLines(), ""))
+	if partition == "" {
+		return "", nil
+	}
+	for _, name := range target.partitions {
+		if name == partition {
+			return partition, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not a partition of %s", partition, target.jobName)
+}
+
+// SwitchLaunchPane moves the focus from the run config editor to the run name, the partition, the tags pane and back
+func SwitchLaunchPane(g *c.Gui, v *c.View) error {
+	next := LAUNCH_NAME_VIEW
+	switch v.Name() {
+	case LAUNCH_NAME_VIEW:
+		next = LAUNCH_TAGS_VIEW
+		if len(currentLaunch.partitions) > 0 {
+			next = LAUNCH_PARTITION
+		}
+	case LAUNCH_PARTITION:
+		next = LAUNCH_TAGS_VIEW
+	case LAUNCH_TAGS_VIEW:
+		next = LAUNCH_RUN_VIEW
+	}
+	_, err := g.SetCurrentView(next)
+	return err
+}
+
+// launchResult is the outcome of launching a run, an invalid run config is not treated as an error
+// since it is shown in the launch window itself
+type launchResult struct {
+	target  launchTarget
+	runId   string
+	invalid *RunConfigValidationError
+}
+
+func asLaunchResult(target launchTarget, runId string, err error) (launchResult, error) {
+	var invalid *RunConfigValidationError
+	if errors.As(err, &invalid) {
+		return launchResult{target: target, invalid: invalid}, nil
+	}
+	return launchResult{target: target, runId: runId}, err
+}
+
+func applyLaunchResult(g *c.Gui, result launchResult) error {
+	launchWindowOpen := true
+	if _, err := g.View(LAUNCH_RUN_VIEW); err != nil {
+		launchWindowOpen = false
+	}
+
+	if result.invalid != nil {
+		if !launchWindowOpen {
+			return OpenErrorWindow(g, result.invalid)
+		}
+		return showConfigValidationErrors(g, result.invalid.Errors)
+	}
+
+	if launchWindowOpen {
+		if err := CloseLaunchWindow(g, LaunchRunWindow.Base.View); err != nil {
+			return err
+		}
+	}
+	// materializations are followed up in the assets view, the runs view only shows the runs of regular jobs
+	if len(result.target.assetSelection) > 0 {
+		return applyMaterializationResult(g, result)
+	}
+	if current := g.CurrentView(); current != nil && current.Name() != RUNS_VIEW {
+		if err := SetFocus(g, RUNS_VIEW, current.Name()); err != nil {
+			return err
+		}
+	}
+	LoadRunsAndSelect(g, result.target.repo.Location, result.target.jobName, result.runId)
+	return openFeedbackPopup(g, "Run Launched", fmt.Sprintf("Launched run %s", result.runId), RUNS_VIEW)
+}
+
+// showLaunchFeedback lists the messages below the launch window, the editor keeps the focus
+func showLaunchFeedback(g *c.Gui, title string, lines []string, color c.Attribute) error {
+	maxX, maxY := g.Size()
+
+	LaunchErrorsView.Initialize(g, title, LAUNCH_ERRORS)
+	if err := LaunchErrorsView.Base.RenderView(g, int(float64(maxX)*0.2), int(float64(maxY)*0.8)+1, int(float64(maxX)*0.8), maxY-1); err != nil {
+		return err
+	}
+	LaunchErrorsView.Base.View.Wrap = true
+	LaunchErrorsView.Base.View.FgColor = color
+	LaunchErrorsView.RenderContent(lines)
+	return nil
+}
+
+// validationComment starts the yaml comment a validation error is shown in at the end of the line it points to,
+// it is cut off again whenever the run config is read from the launch window
+const validationComment = "  # error: "
+
+// runConfigLines returns the content of the launch window without the empty line the last Fprintln leaves behind
+// and without the validation errors
+func runConfigLines() []string {
+	lines := LaunchRunWindow.Base.View.BufferLines()
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for index, line := range lines {
+		if before, _, found := strings.Cut(line, validationComment); found {
+			lines[index] = before
+		}
+	}
+	return lines
+}
+
+// renderRunConfig re-renders the content of the launch window with a red background for the lines that have errors
+// and the errors in a comment behind them, the cursor stays where it is
+func renderRunConfig(lines []string, lineErrors map[int][]string) {
+	ox, oy := LaunchRunWindow.Base.View.Origin()
+	x, y := LaunchRunWindow.Base.View.Cursor()
+	rendered := make([]string, 0)
+	for index, line := range lines {
+		if messages, ok := lineErrors[index]; ok {
+			line = fmt.Sprintf("\x1b[41m%s%s%s\x1b[0m", line, validationComment, strings.Join(messages, "; "))
+		}
+		rendered = append(rendered, line)
+	}
+	LaunchRunWindow.RenderContent(rendered)
+	LaunchRunWindow.Base.View.SetOrigin(ox, oy)
+	LaunchRunWindow.Base.View.SetCursor(x, y)
+}
+
+// showConfigValidationErrors highlights the lines of the run config the errors point to with the errors next to
+// them, the cursor jumps to the first of those lines. All errors are listed below the launch window as well since
+// not every error points to a line
+func showConfigValidationErrors(g *c.Gui, errs []s.ConfigValidationError) error {
+	lines := runConfigLines()
+	firstLine := -1
+	lineErrors := make(map[int][]string)
+	messages := make([]string, 0)
+	for _, err := range errs {
+		message := FormatConfigValidationErrors([]s.ConfigValidationError{err})[0]
+		if line := s.FindYamlLine(lines, ConfigPath(err)); line >= 0 {
+			lineErrors[line] = append(lineErrors[line], message)
+			message = fmt.Sprintf("line %d: %s", line+1, message)
+			if firstLine < 0 || line < firstLine {
+				firstLine = line
+			}
+		}
+		messages = append(messages, message)
+	}
+
+	renderRunConfig(lines, lineErrors)
+	if firstLine >= 0 {
+		moveEditorCursorTo(LaunchRunWindow.Base.View, firstLine)
+	}
+	return showLaunchFeedback(g, "Errors", messages, c.ColorRed)
+}
+
+func moveEditorCursorTo(v *c.View, line int) {
+	_, h := v.Size()
+	if line < h {
+		v.SetOrigin(0, 0)
+		v.SetCursor(0, line)
+	} else {
+		v.SetOrigin(0, line-h+1)
+		v.SetCursor(0, h-1)
+	}
+}
+
+func ValidateRunConfig(g *c.Gui, v *c.View) error {
+	target := currentLaunch
+	lines := runConfigLines()
+
+	FetchAsync(g, "validate_run_config", LaunchRunWindow.Base,
+		func(ctx context.Context) ([]s.ConfigValidationError, error) {
+			return Client.ValidateRunConfig(ctx, target.repo, target.jobName, target.assetSelection, lines)
+		},
+		func(g *c.Gui, errs []s.ConfigValidationError) error {
+			if _, err := g.View(LAUNCH_RUN_VIEW); err != nil {
+				return nil
+			}
+			if len(errs) > 0 {
+				return showConfigValidationErrors(g, errs)
+			}
+			renderRunConfig(runConfigLines(), nil)
+			return showLaunchFeedback(g, "Validation", []string{"Run config is valid"}, c.ColorGreen)
+		})
+	return nil
+}
+
+func clearLaunchErrors(g *c.Gui) error {
+	if _, err := g.View(LAUNCH_ERRORS); err != nil {
+		return nil
+	}
+	return g.DeleteView(LAUNCH_ERRORS)
+}
+
+func CloseLaunchWindow(g *c.Gui, v *c.View) error {
+	if err := clearLaunchErrors(g); err != nil {
+		return err
+	}
+	if err := ClosePopupView(g, LaunchRunWindow.Base.View); err != nil {
+		return err
+	}
+	if err := g.DeleteView(LAUNCH_NAME_VIEW); err != nil {
+		return err
+	}
+	if _, err := g.View(LAUNCH_PARTITION); err == nil {
+		if err := g.DeleteView(LAUNCH_PARTITION); err != nil {
+			return err
+		}
+	}
+	return g.DeleteView(LAUNCH_TAGS_VIEW)
+}
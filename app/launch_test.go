@@ -0,0 +1,94 @@
+package app
+
+import (
+	s "nl/vdb/dagstertui/internal"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []s.Tag
+		// part of the error message, empty when no error is expected
+		wantErr string
+	}{
+		{"no lines", nil, []s.Tag{}, ""},
+		{"key value lines", []string{"team=data", "env = prod "}, []s.Tag{{Key: "team", Value: "data"}, {Key: "env", Value: "prod"}}, ""},
+		{"empty and comment lines are skipped", []string{"", "  ", "# team=data", "env=prod"}, []s.Tag{{Key: "env", Value: "prod"}}, ""},
+		{"empty value", []string{"env="}, []s.Tag{{Key: "env", Value: ""}}, ""},
+		{"value with =", []string{"expr=a=b"}, []s.Tag{{Key: "expr", Value: "a=b"}}, ""},
+		{"run name tag is a regular tag", []string{runNameTag + "=nightly"}, []s.Tag{{Key: runNameTag, Value: "nightly"}}, ""},
+		{"line without =", []string{"env=prod", "team"}, nil, "line 2 is not of the form key=value"},
+		{"line without key", []string{" =prod"}, nil, "line 1 is not of the form key=value"},
+		{"duplicate key", []string{"env=prod", "team=data", "env = test"}, nil, "Tag env is set on line 1 and on line 3"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseTags(test.lines)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("parseTags(%q) error = %v, want it to contain %q", test.lines, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseTags(%q) error = %v", test.lines, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseTags(%q) = %v, want %v", test.lines, got, test.want)
+			}
+		})
+	}
+}
+
+func TestTemplateTags(t *testing.T) {
+	tags := []s.Tag{
+		{Key: "team", Value: "data"},
+		{Key: "dagster/partition", Value: "2024-03-01"},
+		{Key: "dagster/partition_set", Value: "my_job_partition_set"},
+		{Key: "dagster/schedule_name", Value: "daily"},
+		{Key: "dagster/parent_run_id", Value: "abc"},
+		{Key: ".dagster/grpc_info", Value: "{}"},
+		{Key: runNameTag, Value: "nightly"},
+	}
+	want := []s.Tag{
+		{Key: "team", Value: "data"},
+		{Key: "dagster/partition", Value: "2024-03-01"},
+		{Key: "dagster/partition_set", Value: "my_job_partition_set"},
+		{Key: runNameTag, Value: "nightly"},
+	}
+	if got := templateTags(tags); !reflect.DeepEqual(got, want) {
+		t.Errorf("templateTags() = %v, want %v", got, want)
+	}
+	if got := templateTags(nil); len(got) != 0 {
+		t.Errorf("templateTags(nil) = %v, want no tags", got)
+	}
+}
+
+func TestWithRunName(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    []s.Tag
+		runName string
+		want    []s.Tag
+	}{
+		{"adds the run name", []s.Tag{{Key: "env", Value: "prod"}}, "nightly", []s.Tag{{Key: "env", Value: "prod"}, {Key: runNameTag, Value: "nightly"}}},
+		{"replaces the run name", []s.Tag{{Key: runNameTag, Value: "old"}, {Key: "env", Value: "prod"}}, "nightly", []s.Tag{{Key: "env", Value: "prod"}, {Key: runNameTag, Value: "nightly"}}},
+		{"removes the run name when empty", []s.Tag{{Key: runNameTag, Value: "old"}, {Key: "env", Value: "prod"}}, "", []s.Tag{{Key: "env", Value: "prod"}}},
+		{"no tags and no run name", nil, "", []s.Tag{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := withRunName(test.tags, test.runName)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("withRunName(%v, %q) = %v, want %v", test.tags, test.runName, got, test.want)
+			}
+			if name := runName(got); name != test.runName {
+				t.Errorf("runName(%v) = %q, want %q", got, name, test.runName)
+			}
+		})
+	}
+}
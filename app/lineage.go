@@ -0,0 +1,138 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+)
+
+// number of levels shown up- and downstream of the selected asset
+const lineageDepth = 4
+
+// lineageState holds the asset the lineage view got opened for and the latest info of the assets in its lineage
+type lineageState struct {
+	root   s.AssetNode
+	nodes  map[string]s.AssetNode
+	status map[string]s.AssetLatestInfo
+}
+
+var lineage = &lineageState{}
+
+// formatLineageNode colours the asset key by the status of its latest materialization
+func formatLineageNode(key s.AssetKey) string {
+	info, ok := lineage.status[key.String()]
+	switch {
+	case !ok:
+		return key.String()
+	case len(info.InProgressRunIds) > 0 || len(info.UnstartedRunIds) > 0:
+		return fmt.Sprintf("\x1b[33m%s (in progress)\x1b[0m", key.String())
+	case info.LatestRun != nil && (info.LatestRun.Status == "FAILURE" || info.LatestRun.Status == "CANCELED") &&
+		(info.LatestMaterialization == nil || info.LatestMaterialization.RunId != info.LatestRun.RunId):
+		return fmt.Sprintf("\x1b[31m%s (%s)\x1b[0m", key.String(), info.LatestRun.Status)
+	case info.LatestMaterialization != nil:
+		return fmt.Sprintf("\x1b[32m%s (%s)\x1b[0m", key.String(), formatLogTimestamp(info.LatestMaterialization.Timestamp))
+	default:
+		return fmt.Sprintf("%s (never materialized)", key.String())
+	}
+}
+
+// lineageTree renders the dependencies of key as a tree, next returns the keys one level further up- or downstream
+func lineageTree(key s.AssetKey, next func(s.AssetNode) []s.AssetKey, prefix string, depth int) []string {
+	node, ok := lineage.nodes[key.String()]
+	if !ok {
+		return []string{}
+	}
+	children := next(node)
+	if depth == lineageDepth && len(children) > 0 {
+		return []string{fmt.Sprintf("%s└─ …", prefix)}
+	}
+
+	lines := make([]string, 0)
+	for index, child := range children {
+		branch, indent := "├─ ", "│  "
+		if index == len(children)-1 {
+			branch, indent = "└─ ", "   "
+		}
+		lines = append(lines, fmt.Sprintf("%s%s%s", prefix, branch, formatLineageNode(child)))
+		lines = append(lines, lineageTree(child, next, prefix+indent, depth+1)...)
+	}
+	return lines
+}
+
+// lineageKeys returns the keys of all assets shown in the lineage view
+func lineageKeys(key s.AssetKey, next func(s.AssetNode) []s.AssetKey, depth int, keys map[string]s.AssetKey) {
+	keys[key.String()] = key
+	node, ok := lineage.nodes[key.String()]
+	if !ok || depth > lineageDepth {
+		return
+	}
+	for _, child := range next(node) {
+		lineageKeys(child, next, depth+1, keys)
+	}
+}
+
+func upstream(node s.AssetNode) []s.AssetKey {
+	return node.DependencyKeys
+}
+
+func downstream(node s.AssetNode) []s.AssetKey {
+	return node.DependedByKeys
+}
+
+func renderLineage() {
+	lines := []string{"Upstream"}
+	lines = append(lines, lineageTree(lineage.root.AssetKey, upstream, "  ", 1)...)
+	lines = append(lines, "", fmt.Sprintf("● %s", formatLineageNode(lineage.root.AssetKey)), "", "Downstream")
+	lines = append(lines, lineageTree(lineage.root.AssetKey, downstream, "  ", 1)...)
+	AssetLineageWindow.RenderContent(lines)
+}
+
+// OpenAssetLineageWindow shows the up- and downstream assets of the asset under the cursor
+func OpenAssetLineageWindow(g *c.Gui, v *c.View) error {
+	asset, ok := AssetsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	maxX, maxY := g.Size()
+
+	lineage.root = asset
+	lineage.nodes = make(map[string]s.AssetNode)
+	for _, node := range AssetsWindow.RawElements {
+		lineage.nodes[node.AssetKey.String()] = node
+	}
+	lineage.status = make(map[string]s.AssetLatestInfo)
+
+	AssetLineageWindow.Initialize(g, fmt.Sprintf("Lineage of %s", asset.AssetKey.String()), LINEAGE_VIEW)
+	AssetLineageWindow.Base.RenderView(g, int(float64(maxX)*0.15), int(float64(maxY)*0.1), int(float64(maxX)*0.85), int(float64(maxY)*0.9))
+	AssetLineageWindow.Base.SetNavigableFeedback(g)
+	AssetLineageWindow.Base.View.Wrap = false
+	AssetLineageWindow.Base.View.Highlight = true
+	renderLineage()
+
+	keys := make(map[string]s.AssetKey)
+	lineageKeys(asset.AssetKey, upstream, 1, keys)
+	lineageKeys(asset.AssetKey, downstream, 1, keys)
+	assetKeys := make([]s.AssetKey, 0)
+	for _, key := range keys {
+		assetKeys = append(assetKeys, key)
+	}
+
+	FetchAsync(g, LINEAGE_VIEW, AssetLineageWindow.Base,
+		func(ctx context.Context) ([]s.AssetLatestInfo, error) {
+			return Client.GetAssetsLatestInfo(ctx, assetKeys)
+		},
+		func(g *c.Gui, infos []s.AssetLatestInfo) error {
+			// the lineage window got closed while loading
+			if _, err := g.View(LINEAGE_VIEW); err != nil {
+				return nil
+			}
+			for _, info := range infos {
+				lineage.status[info.AssetKey.String()] = info
+			}
+			renderLineage()
+			return nil
+		})
+
+	return State.SetNewActiveWindow(g, v.Name(), LINEAGE_VIEW)
+}
@@ -0,0 +1,262 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+func formatLogLevel(level string) string {
+	padded := fmt.Sprintf("%-8s", level)
+	switch level {
+	case "CRITICAL", "ERROR":
+		return fmt.Sprintf("\x1b[31m%s\x1b[0m", padded)
+	case "WARNING":
+		return fmt.Sprintf("\x1b[33m%s\x1b[0m", padded)
+	case "DEBUG":
+		return fmt.Sprintf("\x1b[36m%s\x1b[0m", padded)
+	default:
+		return padded
+	}
+}
+
+func formatLogTimestamp(timestamp string) string {
+	// dagster sends the timestamp of an event as milliseconds since epoch
+	millis, err := strconv.ParseFloat(timestamp, 64)
+	if err != nil {
+		return timestamp
+	}
+	return time.UnixMilli(int64(millis)).Local().Format("2006-01-02 15:04:05")
+}
+
+func FormatRunEvent(event s.RunEvent) []string {
+	stepKey := event.StepKey
+	if stepKey == "" {
+		stepKey = "-"
+	}
+
+	message := event.Message
+	if event.Error != nil && event.Error.Message != "" {
+		message = fmt.Sprintf("%s\n%s", message, event.Error.Message)
+	}
+	messageLines := strings.Split(strings.TrimRight(message, "\n"), "\n")
+
+	timestamp := formatLogTimestamp(event.Timestamp)
+	lines := []string{fmt.Sprintf("%s  %s  %s  %s", timestamp, formatLogLevel(event.Level), stepKey, messageLines[0])}
+	// align continuation lines with the message column, the width is taken without the colors of the level
+	indent := strings.Repeat(" ", utf8.RuneCountInString(fmt.Sprintf("%s  %-8s  %s  ", timestamp, event.Level, stepKey)))
+	for _, line := range messageLines[1:] {
+		lines = append(lines, fmt.Sprintf("%s%s", indent, line))
+	}
+	return lines
+}
+
+func formatRunEvents(events []s.RunEvent) []string {
+	lines := make([]string, 0)
+	for _, event := range events {
+		lines = append(lines, FormatRunEvent(event)...)
+	}
+	return lines
+}
+
+// logsFollowState keeps track of the run shown in LOGS_VIEW and the cursor up to which its events are rendered.
+// It is only touched from the gocui main loop, the polling goroutine hands its results over via g.Update
+type logsFollowState struct {
+	runId  string
+	cursor string
+	stop   chan struct{}
+}
+
+var logsFollow = &logsFollowState{}
+
+const logsPollInterval = 2 * time.Second
+
+// isRunInProgress returns whether the run has not ended yet, like a run that is queued, running or being cancelled
+func isRunInProgress(status string) bool {
+	return !isRunFinished(status)
+}
+
+// isRunFinished returns whether the run status is one a run ends with
+func isRunFinished(status string) bool {
+	return status == "SUCCESS" || status == "FAILURE" || status == "CANCELED"
+}
+
+func isRunFinishedEvent(event s.RunEvent) bool {
+	switch event.TypeName {
+	case "RunSuccessEvent", "RunFailureEvent", "RunCanceledEvent":
+		return true
+	default:
+		return false
+	}
+}
+
+func setLogsTitle() {
+	title := fmt.Sprintf("Logs - %s", logsFollow.runId)
+	if logsFollow.stop != nil {
+		title = fmt.Sprintf("%s (following)", title)
+	}
+	LogsWindow.Base.SetTitle(title)
+}
+
+func startFollowingLogs(g *c.Gui) {
+	if logsFollow.stop != nil {
+		return
+	}
+	stop := make(chan struct{})
+	logsFollow.stop = stop
+	setLogsTitle()
+
+	go pollLogs(g, logsFollow.runId, logsFollow.cursor, stop)
+}
+
+func stopFollowingLogs() {
+	if logsFollow.stop == nil {
+		return
+	}
+	close(logsFollow.stop)
+	logsFollow.stop = nil
+}
+
+// pollLogs fetches the events after cursor every logsPollInterval until stop is closed or the run has finished
+func pollLogs(g *c.Gui, runId string, cursor string, stop chan struct{}) {
+	ticker := time.NewTicker(logsPollInterval)
+	defer ticker.Stop()
+
+	// cancel a running request as soon as following gets stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		events, nextCursor, err := Client.GetLogs(ctx, runId, cursor)
+		if err != nil {
+			g.Update(func(g *c.Gui) error {
+				if logsFollow.stop != stop {
+					return nil
+				}
+				stopFollowingLogs()
+				setLogsTitle()
+				showAsyncError(g, err)
+				return nil
+			})
+			return
+		}
+		cursor = nextCursor
+
+		finished := false
+		for _, event := range events {
+			finished = finished || isRunFinishedEvent(event)
+		}
+
+		g.Update(func(g *c.Gui) error {
+			// the window got closed or following got toggled in the meantime
+			if logsFollow.stop != stop {
+				return nil
+			}
+			if _, err := g.View(LOGS_VIEW); err != nil {
+				return nil
+			}
+
+			logsFollow.cursor = nextCursor
+			if len(events) > 0 {
+				LogsWindow.AppendContent(formatRunEvents(events))
+				ScrollToBottom(LogsWindow.Base.View)
+			}
+			if finished {
+				stopFollowingLogs()
+				setLogsTitle()
+			}
+			return nil
+		})
+
+		if finished {
+			return
+		}
+	}
+}
+
+func ToggleFollowLogs(g *c.Gui, v *c.View) error {
+	if logsFollow.stop != nil {
+		stopFollowingLogs()
+		setLogsTitle()
+		return nil
+	}
+	startFollowingLogs(g)
+	return ScrollToBottom(LogsWindow.Base.View)
+}
+
+func CloseLogsWindow(g *c.Gui, v *c.View) error {
+	stopFollowingLogs()
+	return ClosePopupView(g, v)
+}
+
+func OpenLogsWindow(g *c.Gui, v *c.View) error {
+	SelectedRun := RunsWindow.GetElementOnCursorPosition()
+	if SelectedRun == "" {
+		return nil
+	}
+	run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+	if !ok {
+		return nil
+	}
+	maxX, maxY := g.Size()
+
+	LogsWindow.Initialize(g, "Logs", LOGS_VIEW)
+	LogsWindow.Base.RenderView(g, int(float64(maxX)*0.05), int(float64(maxY)*0.05), int(float64(maxX)*0.95), int(float64(maxY)*0.95))
+	LogsWindow.Base.SetNavigableFeedback(g)
+	LogsWindow.Base.View.Wrap = false
+	LogsWindow.Base.View.Highlight = true
+
+	stopFollowingLogs()
+	logsFollow.runId = run.RunId
+	logsFollow.cursor = ""
+	setLogsTitle()
+
+	type logsPage struct {
+		events []s.RunEvent
+		cursor string
+	}
+	FetchAsync(g, LOGS_VIEW, LogsWindow.Base,
+		func(ctx context.Context) (logsPage, error) {
+			events, cursor, err := Client.GetLogs(ctx, run.RunId, "")
+			return logsPage{events, cursor}, err
+		},
+		func(g *c.Gui, page logsPage) error {
+			// the logs window got closed while loading
+			if _, err := g.View(LOGS_VIEW); err != nil {
+				return nil
+			}
+			logsFollow.cursor = page.cursor
+			LogsWindow.RenderContent(formatRunEvents(page.events))
+
+			// the status in the runs list can be outdated, the run may have finished since it got loaded
+			finished := false
+			for _, event := range page.events {
+				finished = finished || isRunFinishedEvent(event)
+			}
+			if isRunInProgress(run.Status) && !finished {
+				startFollowingLogs(g)
+				return ScrollToBottom(LogsWindow.Base.View)
+			}
+			return nil
+		})
+
+	return State.SetNewActiveWindow(g, v.Name(), LOGS_VIEW)
+}
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	s "nl/vdb/dagstertui/internal"
+)
+
+func (c *GraphQLClient) GetPartitionSets(ctx context.Context, repository s.RepositoryRepresentation, jobName string) ([]s.PartitionSet, error) {
+	query := `query PartitionSetsQuery(
+		$repositoryLocationName: String!
+		$repositoryName: String!
+		$pipelineName: String!
+	) {
+		partitionSetsOrError(
+			repositorySelector: {
+				repositoryLocationName: $repositoryLocationName
+				repositoryName: $repositoryName
+			}
+			pipelineName: $pipelineName
+		) {
+			__typename
+			... on PartitionSets {
+			results {
+				name
+				mode
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
+		"pipelineName":           jobName,
+	}
+
+	var response s.PartitionSetsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	partitionSets := response.PartitionSetsOrError
+	if err := unionError(partitionSets.TypeName, partitionSets.Message, "PartitionSets"); err != nil {
+		return nil, err
+	}
+	return partitionSets.Results, nil
+}
+
+func (c *GraphQLClient) GetPartitionNames(ctx context.Context, repository s.RepositoryRepresentation, partitionSetName string) ([]string, error) {
+	query := `query PartitionNamesQuery(
+		$repositoryLocationName: String!
+		$repositoryName: String!
+		$partitionSetName: String!
+	) {
+		partitionSetOrError(
+			repositorySelector: {
+				repositoryLocationName: $repositoryLocationName
+				repositoryName: $repositoryName
+			}
+			partitionSetName: $partitionSetName
+		) {
+			__typename
+			... on PartitionSet {
+			partitionsOrError {
+				__typename
+				... on Partitions {
+				results {
+					name
+				}
+				}
+				... on Error {
+				message
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
+		"partitionSetName":       partitionSetName,
+	}
+
+	var response s.PartitionNamesResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	partitionSet := response.PartitionSetOrError
+	if err := unionError(partitionSet.TypeName, partitionSet.Message, "PartitionSet"); err != nil {
+		return nil, err
+	}
+	partitions := partitionSet.PartitionsOrError
+	if err := unionError(partitions.TypeName, partitions.Message, "Partitions"); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for _, partition := range partitions.Results {
+		names = append(names, partition.Name)
+	}
+	return names, nil
+}
+
+// GetPartitions returns the partitions of all partition sets of a job
+func (c *GraphQLClient) GetPartitions(ctx context.Context, repository s.RepositoryRepresentation, jobName string) ([]s.PartitionRepresentation, error) {
+	partitionSets, err := c.GetPartitionSets(ctx, repository, jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]s.PartitionRepresentation, 0)
+	for _, partitionSet := range partitionSets {
+		names, err := c.GetPartitionNames(ctx, repository, partitionSet.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			partitions = append(partitions, s.PartitionRepresentation{SetName: partitionSet.Name, Name: name})
+		}
+	}
+	return partitions, nil
+}
+
+func (c *GraphQLClient) GetPartitionRunConfig(ctx context.Context, repository s.RepositoryRepresentation, partition s.PartitionRepresentation) (s.PartitionRunConfig, error) {
+	query := `query PartitionRunConfigQuery(
+		$repositoryLocationName: String!
+		$repositoryName: String!
+		$partitionSetName: String!
+		$partitionName: String!
+	) {
+		partitionSetOrError(
+			repositorySelector: {
+				repositoryLocationName: $repositoryLocationName
+				repositoryName: $repositoryName
+			}
+			partitionSetName: $partitionSetName
+		) {
+			__typename
+			... on PartitionSet {
+			partition(partitionName: $partitionName) {
+				runConfigOrError {
+				__typename
+				... on PartitionRunConfig {
+					yaml
+				}
+				... on Error {
+					message
+				}
+				}
+				tagsOrError {
+				__typename
+				... on PartitionTags {
+					results {
+					key
+					value
+					}
+				}
+				... on Error {
+					message
+				}
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
+		"partitionSetName":       partition.SetName,
+		"partitionName":          partition.Name,
+	}
+
+	var response s.PartitionResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return s.PartitionRunConfig{}, err
+	}
+
+	partitionSet := response.PartitionSetOrError
+	if err := unionError(partitionSet.TypeName, partitionSet.Message, "PartitionSet"); err != nil {
+		return s.PartitionRunConfig{}, err
+	}
+	if partitionSet.Partition == nil {
+		return s.PartitionRunConfig{}, &DagsterError{TypeName: "PartitionNotFound", Message: fmt.Sprintf("No partition %s in %s", partition.Name, partition.SetName)}
+	}
+	runConfig := partitionSet.Partition.RunConfigOrError
+	if err := unionError(runConfig.TypeName, runConfig.Message, "PartitionRunConfig"); err != nil {
+		return s.PartitionRunConfig{}, err
+	}
+	tags := partitionSet.Partition.TagsOrError
+	if err := unionError(tags.TypeName, tags.Message, "PartitionTags"); err != nil {
+		return s.PartitionRunConfig{}, err
+	}
+	return s.PartitionRunConfig{RunConfigYaml: runConfig.Yaml, Tags: tags.Results}, nil
+}
@@ -0,0 +1,302 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+)
+
+// partitionPickerState holds all partitions of the job the picker got opened for, the filter typed into the picker
+// and the partitions selected for a backfill
+type partitionPickerState struct {
+	jobName      string
+	partitions   []s.PartitionRepresentation
+	multipleSets bool
+	filter       string
+	selected     map[s.PartitionRepresentation]bool
+	// partition that got toggled last, start of a range selection
+	lastToggled s.PartitionRepresentation
+}
+
+var partitionPicker = &partitionPickerState{}
+
+var PartitionFilterEditor c.Editor = c.EditorFunc(partitionFilterEditor)
+
+// partitionFilterEditor turns everything typed into the partition picker into its filter
+func partitionFilterEditor(v *c.View, key c.Key, ch rune, mod c.Modifier) {
+	switch {
+	case ch != 0 && mod == 0:
+		partitionPicker.filter += string(ch)
+	case key == c.KeySpace:
+		togglePartitionUnderCursor()
+		return
+	case (key == c.KeyBackspace || key == c.KeyBackspace2) && len(partitionPicker.filter) > 0:
+		runes := []rune(partitionPicker.filter)
+		partitionPicker.filter = string(runes[:len(runes)-1])
+	default:
+		return
+	}
+	renderPartitions()
+}
+
+func partitionLabel(p s.PartitionRepresentation) string {
+	marker := "[ ]"
+	if partitionPicker.selected[p] {
+		marker = "[x]"
+	}
+	if partitionPicker.multipleSets {
+		return fmt.Sprintf("%s %s \t (%s)", marker, p.Name, p.SetName)
+	}
+	return fmt.Sprintf("%s %s", marker, p.Name)
+}
+
+func filteredPartitions() []s.PartitionRepresentation {
+	return s.Filter(partitionPicker.partitions, func(p s.PartitionRepresentation) bool {
+		return strings.Contains(p.Name, partitionPicker.filter)
+	})
+}
+
+func renderPartitions() {
+	PartitionsWindow.RenderItems(filteredPartitions(), false)
+	PartitionsWindow.ResetCursor()
+	setPartitionsTitle()
+}
+
+// refreshPartitions re-renders the partitions after the selection changed, the cursor stays in place
+func refreshPartitions() {
+	PartitionsWindow.RefreshItems()
+	setPartitionsTitle()
+}
+
+func setPartitionsTitle() {
+	title := fmt.Sprintf("Partitions of %s", partitionPicker.jobName)
+	if partitionPicker.filter != "" {
+		title = fmt.Sprintf("%s - filter: %s", title, partitionPicker.filter)
+	}
+	if len(partitionPicker.selected) > 0 {
+		title = fmt.Sprintf("%s - %d selected", title, len(partitionPicker.selected))
+	}
+	PartitionsWindow.Base.SetTitle(title)
+}
+
+func setPartitionSelected(p s.PartitionRepresentation, selected bool) {
+	if selected {
+		partitionPicker.selected[p] = true
+	} else {
+		delete(partitionPicker.selected, p)
+	}
+}
+
+func togglePartitionUnderCursor() {
+	partition, ok := PartitionsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return
+	}
+	setPartitionSelected(partition, !partitionPicker.selected[partition])
+	partitionPicker.lastToggled = partition
+	refreshPartitions()
+}
+
+// SelectAllPartitions selects all partitions matching the filter, or unselects them if they are all selected already
+func SelectAllPartitions(g *c.Gui, v *c.View) error {
+	filtered := filteredPartitions()
+	allSelected := true
+	for _, p := range filtered {
+		allSelected = allSelected && partitionPicker.selected[p]
+	}
+	for _, p := range filtered {
+		setPartitionSelected(p, !allSelected)
+	}
+	refreshPartitions()
+	return nil
+}
+
+// SelectPartitionRange selects all partitions between the partition toggled last and the one under the cursor,
+// when no partition got toggled yet or the last toggled one is filtered out only the one under the cursor is toggled
+func SelectPartitionRange(g *c.Gui, v *c.View) error {
+	current, ok := PartitionsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	start, end := -1, -1
+	for index, p := range PartitionsWindow.RawElements {
+		if p == current {
+			end = index
+		}
+		if p == partitionPicker.lastToggled {
+			start = index
+		}
+	}
+	if start < 0 || end < 0 {
+		togglePartitionUnderCursor()
+		return nil
+	}
+	if start > end {
+		start, end = end, start
+	}
+	for _, p := range PartitionsWindow.RawElements[start : end+1] {
+		setPartitionSelected(p, true)
+	}
+	partitionPicker.lastToggled = current
+	refreshPartitions()
+	return nil
+}
+
+// OpenPartitionPicker loads the partitions of the selected job and opens the picker once they are there,
+// a job without partitions only gets an error
+func OpenPartitionPicker(g *c.Gui, v *c.View) error {
+	if State.SelectedJob == "" {
+		return nil
+	}
+	repo := Overview.GetRepoByLocation(State.SelectedRepo)
+	jobName := State.SelectedJob
+	returnWindow := v.Name()
+	target := JobsWindow.Base
+	if returnWindow == RUNS_VIEW {
+		target = RunsWindow.Base
+	}
+
+	FetchAsync(g, PARTITIONS_VIEW, target,
+		func(ctx context.Context) ([]s.PartitionRepresentation, error) {
+			return Client.GetPartitions(ctx, repo, jobName)
+		},
+		func(g *c.Gui, partitions []s.PartitionRepresentation) error {
+			// the focus moved on while loading
+			if current := g.CurrentView(); current == nil || current.Name() != returnWindow {
+				return nil
+			}
+			if len(partitions) == 0 {
+				return OpenErrorWindow(g, fmt.Errorf("Job %s is not partitioned", jobName))
+			}
+			return openPartitionPicker(g, returnWindow, jobName, partitions)
+		})
+	return nil
+}
+
+func openPartitionPicker(g *c.Gui, returnWindow string, jobName string, partitions []s.PartitionRepresentation) error {
+	maxX, maxY := g.Size()
+
+	PartitionsWindow.Initialize(g, "Partitions", PARTITIONS_VIEW, partitionLabel, func(p s.PartitionRepresentation) string { return p.Name })
+	PartitionsWindow.Base.RenderView(g, int(float64(maxX)*0.3), int(float64(maxY)*0.15), int(float64(maxX)*0.7), int(float64(maxY)*0.85))
+	PartitionsWindow.Base.SetNavigableFeedback(g)
+	PartitionsWindow.Base.View.Editable = true
+	PartitionsWindow.Base.View.Editor = PartitionFilterEditor
+
+	// most recent partitions first, dagster returns them in ascending order
+	reversed := make([]s.PartitionRepresentation, 0)
+	sets := make(map[string]bool)
+	for i := len(partitions) - 1; i >= 0; i-- {
+		reversed = append(reversed, partitions[i])
+		sets[partitions[i].SetName] = true
+	}
+	partitionPicker.jobName = jobName
+	partitionPicker.partitions = reversed
+	partitionPicker.multipleSets = len(sets) > 1
+	partitionPicker.filter = ""
+	partitionPicker.selected = make(map[s.PartitionRepresentation]bool)
+	partitionPicker.lastToggled = s.PartitionRepresentation{}
+	renderPartitions()
+
+	return State.SetNewActiveWindow(g, returnWindow, PARTITIONS_VIEW)
+}
+
+// setTag returns the tags with the value of key replaced, or added if the key is not present yet
+func setTag(tags []s.Tag, key string, value string) []s.Tag {
+	result := make([]s.Tag, 0)
+	for _, tag := range tags {
+		if tag.Key != key {
+			result = append(result, tag)
+		}
+	}
+	return append(result, s.Tag{Key: key, Value: value})
+}
+
+// LaunchPartition opens the launch window with the run config and tags of the partition under the cursor
+func LaunchPartition(g *c.Gui, v *c.View) error {
+	partition, ok := PartitionsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	repo := Overview.GetRepoByLocation(State.SelectedRepo)
+
+	FetchAsync(g, "partition_run_config", PartitionsWindow.Base,
+		func(ctx context.Context) (s.PartitionRunConfig, error) {
+			return Client.GetPartitionRunConfig(ctx, repo, partition)
+		},
+		func(g *c.Gui, runConfig s.PartitionRunConfig) error {
+			// the picker got closed while loading, the launch got cancelled
+			if _, err := g.View(PARTITIONS_VIEW); err != nil {
+				return nil
+			}
+			if err := ClosePopupView(g, PartitionsWindow.Base.View); err != nil {
+				return err
+			}
+
+			tags := setTag(runConfig.Tags, "dagster/partition", partition.Name)
+			tags = setTag(tags, "dagster/partition_set", partition.SetName)
+			if err := openLaunchPopup(g, g.CurrentView(), launchTarget{repo: repo, jobName: partitionPicker.jobName}, runConfig.RunConfigYaml, tags); err != nil {
+				return err
+			}
+			LaunchRunWindow.Base.SetTitle(fmt.Sprintf("Launch Run For %s - partition %s", partitionPicker.jobName, partition.Name))
+			return nil
+		})
+	return nil
+}
+
+// selectedPartitions returns the selected partitions in chronological order, or the partition under the cursor
+// when nothing is selected
+func selectedPartitions() []s.PartitionRepresentation {
+	selected := make([]s.PartitionRepresentation, 0)
+	for i := len(partitionPicker.partitions) - 1; i >= 0; i-- {
+		if partitionPicker.selected[partitionPicker.partitions[i]] {
+			selected = append(selected, partitionPicker.partitions[i])
+		}
+	}
+	if len(selected) == 0 {
+		if partition, ok := PartitionsWindow.GetRawElementOnCursorPosition(); ok {
+			selected = append(selected, partition)
+		}
+	}
+	return selected
+}
+
+// LaunchPartitionBackfill asks for confirmation and launches a backfill for the selected partitions
+func LaunchPartitionBackfill(g *c.Gui, v *c.View) error {
+	partitions := selectedPartitions()
+	if len(partitions) == 0 {
+		return nil
+	}
+	setName := partitions[0].SetName
+	names := make([]string, 0)
+	for _, p := range partitions {
+		if p.SetName != setName {
+			return OpenErrorWindow(g, fmt.Errorf("A backfill can only be launched for partitions of a single partition set"))
+		}
+		names = append(names, p.Name)
+	}
+	repo := Overview.GetRepoByLocation(State.SelectedRepo)
+
+	message := fmt.Sprintf("Launch backfill for %d partitions (%s - %s)?", len(names), names[0], names[len(names)-1])
+	return AskConfirmation(g, v, message, func(g *c.Gui) error {
+		MutateAsync(g, "launch_backfill", PartitionsWindow.Base,
+			func(ctx context.Context) (string, error) {
+				return Client.LaunchBackfill(ctx, repo, setName, names)
+			},
+			func(g *c.Gui, backfillId string) error {
+				if _, err := g.View(PARTITIONS_VIEW); err == nil {
+					if err := ClosePopupView(g, PartitionsWindow.Base.View); err != nil {
+						return err
+					}
+				}
+				returnWindow := JOBS_VIEW
+				if current := g.CurrentView(); current != nil {
+					returnWindow = current.Name()
+				}
+				message := fmt.Sprintf("Backfill %s launched for %d partitions of %s\nPress B to monitor backfills", backfillId, len(names), setName)
+				return openFeedbackPopup(g, "Backfill Launched", message, returnWindow)
+			})
+		return nil
+	})
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+)
+
+// reexecuteSelectedRun asks for confirmation and re-executes the selected run with the given strategy,
+// the new run gets selected once it is launched
+func reexecuteSelectedRun(g *c.Gui, v *c.View, strategy string, message string) error {
+	SelectedRun := RunsWindow.GetElementOnCursorPosition()
+	if SelectedRun == "" {
+		return nil
+	}
+	run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+	if !ok {
+		return nil
+	}
+	if isRunInProgress(run.Status) {
+		return OpenErrorWindow(g, fmt.Errorf("Run %s has not finished yet", run.RunId))
+	}
+	if strategy == "FROM_FAILURE" && run.Status != "FAILURE" {
+		return OpenErrorWindow(g, fmt.Errorf("Run %s did not fail, it can only be re-executed with all steps", run.RunId))
+	}
+	target := selectedJobTarget()
+
+	return AskConfirmation(g, v, message, func(g *c.Gui) error {
+		MutateAsync(g, "reexecute_run", RunsWindow.Base,
+			func(ctx context.Context) (launchResult, error) {
+				runId, err := Client.ReexecuteRun(ctx, run.RunId, strategy)
+				return asLaunchResult(target, runId, err)
+			},
+			func(g *c.Gui, result launchResult) error {
+				return applyLaunchResult(g, result)
+			})
+		return nil
+	})
+}
+
+func ReexecuteFromFailure(g *c.Gui, v *c.View) error {
+	return reexecuteSelectedRun(g, v, "FROM_FAILURE", "Re-execute from failure?")
+}
+
+func ReexecuteAllSteps(g *c.Gui, v *c.View) error {
+	return reexecuteSelectedRun(g, v, "ALL_STEPS", "Re-execute all steps?")
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"time"
+)
+
+// refreshPaused returns whether refreshing should wait, that is when focus is on a popup or an editor instead of
+// one of the main windows
+func refreshPaused(g *c.Gui) bool {
+	current := g.CurrentView()
+	if current == nil {
+		return true
+	}
+	switch current.Name() {
+	case REPOSITORIES_VIEW, JOBS_VIEW, RUNS_VIEW:
+		return false
+	default:
+		return true
+	}
+}
+
+// StartAutoRefresh reloads the repositories and the runs of the selected job every interval,
+// an interval of 0 disables refreshing
+func StartAutoRefresh(g *c.Gui, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			g.Update(func(g *c.Gui) error {
+				if refreshPaused(g) {
+					return nil
+				}
+				refreshRepositories(g)
+				refreshRuns(g)
+				return nil
+			})
+		}
+	}()
+}
+
+// refreshRepositories reloads the repository list, the jobs loaded for a repository and the cursor position are kept
+func refreshRepositories(g *c.Gui) {
+	// the first load has not finished yet
+	if requestInFlight(REPOSITORIES_VIEW) {
+		return
+	}
+	FetchAsyncHandlingErrors(g, "refresh_repositories", RepoWindow.Base, Client.LoadRepositories,
+		func(g *c.Gui, repos []s.Repository) error {
+			if refreshPaused(g) {
+				return nil
+			}
+			Overview.MergeRepositories(repos, State.SelectedRepo)
+			RepoWindow.ReplaceItems(filteredRepositories(), func(a, b s.RepositoryRepresentation) bool {
+				return a.Location == b.Location
+			})
+			return nil
+		},
+		func(g *c.Gui, err error) {
+			showStatus(fmt.Sprintf("Refreshing the repositories failed: %s", err))
+		})
+}
+
+// refreshRuns reloads the runs of the selected job, the cursor stays on the same run
+func refreshRuns(g *c.Gui) {
+	location, jobName := State.SelectedRepo, State.SelectedJob
+	if location == "" || jobName == "" {
+		return
+	}
+	// the runs of a newly selected job or the next page are still loading. Both share the key with the refresh,
+	// so loading them cancels a running refresh that would otherwise drop the runs they add
+	if requestInFlight(RUNS_VIEW) {
+		return
+	}
+	// the repository got dropped by a reload of the repositories
+	if _, ok := Overview.Repositories[location]; !ok {
+		return
+	}
+	repo := Overview.GetRepoByLocation(location)
+	// reload all pages loaded so far in one go
+	limit := len(Overview.GetRunsFor(location, jobName))
+	if limit < runsPageSize() {
+		limit = runsPageSize()
+	}
+
+	FetchAsyncHandlingErrors(g, RUNS_VIEW, RunsWindow.Base,
+		func(ctx context.Context) (s.PipelineOrError, error) {
+			return Client.GetPipelineRuns(ctx, repo, jobName, "", limit)
+		},
+		func(g *c.Gui, pipelineRuns s.PipelineOrError) error {
+			// another job got selected in the meantime
+			if refreshPaused(g) || State.SelectedRepo != location || State.SelectedJob != jobName {
+				return nil
+			}
+			Overview.UpdatePipelineAndRuns(location, pipelineRuns)
+			RunsWindow.ReplaceItems(Overview.GetRunsFor(location, jobName), sameRun, false)
+			setRunsTitle(jobName)
+			setRunInformation(RunsWindow.Base.View)
+			return nil
+		},
+		func(g *c.Gui, err error) {
+			showStatus(fmt.Sprintf("Refreshing the runs of %s failed: %s", jobName, err))
+		})
+}
@@ -2,115 +2,192 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	s "nl/vdb/dagstertui/internal"
 	"regexp"
 	"strings"
 )
 
+const logsPageSize = 1000
+
+var operationNameRegex = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+// configValidationErrorFragment selects the errors of a RunConfigValidationInvalid including the path in the run config
+const configValidationErrorFragment = `
+	fragment ConfigValidationErrorFragment on PipelineConfigValidationError {
+		message
+		reason
+		stack {
+		entries {
+			__typename
+			... on EvaluationStackPathEntry {
+			fieldName
+			}
+			... on EvaluationStackListItemEntry {
+			listIndex
+			}
+			... on EvaluationStackMapKeyEntry {
+			mapKey
+			}
+			... on EvaluationStackMapValueEntry {
+			mapKey
+			}
+		}
+		}
+	}`
+
 type GraphQLClient struct {
 	Url string
 }
 
-func (c *GraphQLClient) LoadRepositories() []s.Repository {
+// Execute sends the query with its variables to dagster and unmarshals the data of the answer into out.
+// Failures are reported as TransportError, HTTPStatusError, DecodeError or GraphQLError
+func (c *GraphQLClient) Execute(ctx context.Context, query string, variables map[string]any, out any) error {
+	request := s.GraphQLRequest{
+		Query:     query,
+		Variables: variables,
+	}
+	if match := operationNameRegex.FindStringSubmatch(query); match != nil {
+		request.OperationName = match[1]
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
 
-	query := "query RepositoriesQuery { repositoriesOrError { ... on RepositoryConnection { nodes { name location { name }}}}}"
-	var reqStr = []byte(fmt.Sprintf(`{ 
-		"query": "%s"
-		}`, query))
-	req, reqErr := http.NewRequest("POST", c.Url, bytes.NewBuffer(reqStr))
+	req, reqErr := http.NewRequestWithContext(ctx, "POST", c.Url, bytes.NewBuffer(body))
 	if reqErr != nil {
-		panic(reqErr)
+		return &TransportError{Url: c.Url, Err: reqErr}
 	}
 	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
 	client := &http.Client{}
 	resp, respErr := client.Do(req)
 	if respErr != nil {
-		log.Fatalf("Failed POST request: %v", respErr)
+		return &TransportError{Url: c.Url, Err: respErr}
 	}
 	defer resp.Body.Close()
 
 	jsonData, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Fatalf("Failed to read response body: %v", err)
+		return &TransportError{Url: c.Url, Err: err}
+	}
+
+	// dagster answers invalid queries with a 400 and an errors array, so look for those first
+	var response s.GraphQLResponse
+	decodeErr := json.Unmarshal(jsonData, &response)
+	if decodeErr == nil && len(response.Errors) > 0 {
+		messages := make([]string, 0)
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return &GraphQLError{Messages: messages}
 	}
 
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &HTTPStatusError{StatusCode: resp.StatusCode, Body: string(jsonData)}
+	}
+
+	if decodeErr != nil {
+		return &DecodeError{Err: decodeErr, Body: string(jsonData)}
+	}
+	if err := json.Unmarshal(response.Data, out); err != nil {
+		return &DecodeError{Err: err, Body: string(jsonData)}
+	}
+	return nil
+}
+
+func (c *GraphQLClient) LoadRepositories(ctx context.Context) ([]s.Repository, error) {
+	query := `query RepositoriesQuery {
+		repositoriesOrError {
+			__typename
+			... on RepositoryConnection {
+			nodes {
+				name
+				location {
+					name
+				}
+			}
+			}
+			... on PythonError {
+			message
+			}
+		}
+	}`
+
 	var response s.RepositoriesResponse
-	if err := json.Unmarshal([]byte(jsonData), &response); err != nil {
-		log.Fatalf("Failed to parse JSON: %v, %s", err, string(jsonData))
+	if err := c.Execute(ctx, query, nil, &response); err != nil {
+		return nil, err
 	}
 
-	repos := response.Data.RepositoriesOrError.Nodes
-	return repos
+	repositoriesOrError := response.RepositoriesOrError
+	if err := unionError(repositoriesOrError.TypeName, repositoriesOrError.Message, "RepositoryConnection"); err != nil {
+		return nil, err
+	}
+	return repositoriesOrError.Nodes, nil
 }
 
-func (c *GraphQLClient) GetJobsInRepository(repository s.RepositoryRepresentation) []s.Job {
-	re := regexp.MustCompile(`[\s]`)
+func (c *GraphQLClient) GetJobsInRepository(ctx context.Context, repository s.RepositoryRepresentation) ([]s.Job, error) {
 	query := `query JobsQuery($repositoryLocationName: String!, $repositoryName: String!) {
 	repositoryOrError(
 		repositorySelector: {
-		repositoryLocationName: $repositoryLocationName 
-		repositoryName: $repositoryName 
+		repositoryLocationName: $repositoryLocationName
+		repositoryName: $repositoryName
 		}
 	) {
+		__typename
 		... on Repository {
 		jobs {
-			name 
-			id 
-			description 
+			name
+			id
+			description
 		}
 		}
+		... on RepositoryNotFoundError {
+		message
+		}
+		... on PythonError {
+		message
+		}
 	}}`
-	query = re.ReplaceAllString(query, " ")
-	str := fmt.Sprintf(`{
-		"query": "%s",
-		"variables": { "repositoryName": "%s", "repositoryLocationName": "%s" }
-	}`, query, repository.Name, repository.Location)
-
-	var reqStr = []byte(str)
-	req, reqErr := http.NewRequest("POST", c.Url, bytes.NewBuffer(reqStr))
-	if reqErr != nil {
-		panic(reqErr)
-	}
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
-
-	client := &http.Client{}
-	resp, respErr := client.Do(req)
-	if respErr != nil {
-		log.Fatalf("Failed POST request: %v", respErr)
-	}
-	defer resp.Body.Close()
-
-	jsonData, err := ioutil.ReadAll(resp.Body)
-
-	if err != nil {
-		log.Fatalf("Failed to read response body: %v", err)
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
 	}
 
 	var response s.JobsResponse
-	if err := json.Unmarshal([]byte(jsonData), &response); err != nil {
-		log.Fatalln(string(jsonData))
-		log.Fatalf("Failed to parse JSON: %v", err)
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
 	}
 
-	jobs := response.Data.RepositoriesOrError.Jobs
-	return jobs
+	repositoryOrError := response.RepositoriesOrError
+	if err := unionError(repositoryOrError.TypeName, repositoryOrError.Message, "Repository"); err != nil {
+		return nil, err
+	}
+	return repositoryOrError.Jobs, nil
 }
 
-func (c *GraphQLClient) GetPipelineRuns(repository s.RepositoryRepresentation, jobName string, limit int) s.PipelineOrError {
-	query := fmt.Sprintf(`query RunIdsQuery {
+// GetPipelineRuns returns the job with its most recent runs, cursor is the id of the last run of the previous page
+// and empty for the first page
+func (c *GraphQLClient) GetPipelineRuns(ctx context.Context, repository s.RepositoryRepresentation, jobName string, cursor string, limit int) (s.PipelineOrError, error) {
+	query := `query RunIdsQuery(
+		$repositoryName: String!
+		$repositoryLocationName: String!
+		$pipelineName: String!
+		$cursor: String
+		$limit: Int
+	) {
 	pipelineOrError(
 		params: {
-		repositoryName: "%s"
-		pipelineName:"%s"
-		repositoryLocationName:"%s"
+		repositoryName: $repositoryName
+		pipelineName: $pipelineName
+		repositoryLocationName: $repositoryLocationName
 		}
 	) {
+		__typename
 		...on Pipeline {
 		id
 		name
@@ -118,60 +195,65 @@ func (c *GraphQLClient) GetPipelineRuns(repository s.RepositoryRepresentation, j
 				runConfigYaml
 		}
 		runs(
-			limit: %d
+			cursor: $cursor
+			limit: $limit
 		) {
 			runId
 			status
 			startTime
 			endTime
 			runConfigYaml
+			tags {
+				key
+				value
+			}
 		}
 		}
-		
 		...on PipelineNotFoundError {
 		message
 		}
-	}}`, repository.Name, jobName, repository.Location, limit)
-	query = regexp.MustCompile(`[\s]`).ReplaceAllString(query, " ")
-	query = regexp.MustCompile(`"`).ReplaceAllString(query, `\"`)
-
-	var reqStr = []byte(fmt.Sprintf(`{ 
-		"query": "%s"
-		}`, query))
-	req, reqErr := http.NewRequest("POST", c.Url, bytes.NewBuffer(reqStr))
-	if reqErr != nil {
-		panic(reqErr)
+		...on RepositoryNotFoundError {
+		message
+		}
+		...on InvalidSubsetError {
+		message
+		}
+		...on PythonError {
+		message
+		}
+	}}`
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
+		"pipelineName":           jobName,
+		"cursor":                 nil,
+		"limit":                  limit,
 	}
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
-
-	client := &http.Client{}
-	resp, respErr := client.Do(req)
-	if respErr != nil {
-		log.Fatalf("Failed POST request: %v", respErr)
-	}
-	defer resp.Body.Close()
-
-	jsonData, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Fatalf("Failed to read response body: %v", err)
+	if cursor != "" {
+		variables["cursor"] = cursor
 	}
 
 	var response s.RunsResponse
-	if err := json.Unmarshal([]byte(jsonData), &response); err != nil {
-		log.Fatalf("Failed to parse JSON: %v, %s", err, string(jsonData))
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return s.PipelineOrError{}, err
 	}
 
-	pipelineOrError := response.Data.PipelineOrError
-
-	return pipelineOrError
+	pipelineOrError := response.PipelineOrError
+	if err := unionError(pipelineOrError.TypeName, pipelineOrError.Message, "Pipeline"); err != nil {
+		return s.PipelineOrError{}, err
+	}
+	return pipelineOrError, nil
 }
 
-func (c *GraphQLClient) LaunchRunForJob(repository s.RepositoryRepresentation, jobName string, runConfigYamlLines []string) string {
+// LaunchRunForJob launches a run of the job, with an asset selection only the selected assets of the job get materialized
+func (c *GraphQLClient) LaunchRunForJob(ctx context.Context, repository s.RepositoryRepresentation, jobName string, assetSelection []s.AssetKey, runConfigYamlLines []string, tags []s.Tag) (string, error) {
 	query := `mutation LaunchRunMutation(
 		$repositoryLocationName: String!
 		$repositoryName: String!
 		$jobName: String!
+		$assetSelection: [AssetKeyInput!]
 		$runConfigData: RunConfigData!
+		$executionMetadata: ExecutionMetadata
 	) {
 		launchRun(
 			executionParams: {
@@ -179,8 +261,10 @@ func (c *GraphQLClient) LaunchRunForJob(repository s.RepositoryRepresentation, j
 				repositoryLocationName: $repositoryLocationName
 				repositoryName: $repositoryName
 				jobName: $jobName
+				assetSelection: $assetSelection
 			}
 			runConfigData: $runConfigData
+			executionMetadata: $executionMetadata
 			}
 		) {
 			__typename
@@ -191,52 +275,98 @@ func (c *GraphQLClient) LaunchRunForJob(repository s.RepositoryRepresentation, j
 			}
 			... on RunConfigValidationInvalid {
 			errors {
-				message
-				reason
+				...ConfigValidationErrorFragment
 			}
 			}
-			... on PythonError {
+			... on Error {
 			message
 			}
 		}
-	}`
-	query = regexp.MustCompile(`[\s]`).ReplaceAllString(query, " ")
-	query = regexp.MustCompile(`"`).ReplaceAllString(query, `\"`)
-
-	str := fmt.Sprintf(`{
-		"query": "%s",
-		"variables": { "repositoryName": "%s", "repositoryLocationName": "%s" , "jobName": "%s", "runConfigData": "%s"}
-	}`, query, repository.Name, repository.Location, jobName, strings.Join(runConfigYamlLines, "\\n"))
+	}` + configValidationErrorFragment
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
+		"jobName":                jobName,
+		"assetSelection":         assetSelection,
+		// RunConfigData accepts the yaml as a plain string
+		"runConfigData": strings.Join(runConfigYamlLines, "\n"),
+		"executionMetadata": map[string]any{
+			"tags": tags,
+		},
+	}
 
-	var reqStr = []byte(str)
-	req, reqErr := http.NewRequest("POST", c.Url, bytes.NewBuffer(reqStr))
-	if reqErr != nil {
-		panic(reqErr)
+	var response s.LaunchRunResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return "", err
 	}
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
-	client := &http.Client{}
-	resp, respErr := client.Do(req)
-	if respErr != nil {
-		log.Fatalf("Failed POST request: %v", respErr)
+	launchRun := response.LaunchRun
+	if launchRun.TypeName == "RunConfigValidationInvalid" {
+		return "", &RunConfigValidationError{Errors: launchRun.Errors}
 	}
-	defer resp.Body.Close()
+	if err := unionError(launchRun.TypeName, launchRun.Message, "LaunchRunSuccess"); err != nil {
+		return "", err
+	}
+	return launchRun.Run.RunId, nil
+}
 
-	jsonData, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Fatalf("Failed to read response body: %v", err)
+// ValidateRunConfig checks the run config against the config schema of the job, the returned errors are empty for a valid config
+func (c *GraphQLClient) ValidateRunConfig(ctx context.Context, repository s.RepositoryRepresentation, jobName string, assetSelection []s.AssetKey, runConfigYamlLines []string) ([]s.ConfigValidationError, error) {
+	query := `query ValidateRunConfigQuery(
+		$repositoryLocationName: String!
+		$repositoryName: String!
+		$pipelineName: String!
+		$assetSelection: [AssetKeyInput!]
+		$runConfigData: RunConfigData
+		$mode: String!
+	) {
+		isPipelineConfigValid(
+			pipeline: {
+				repositoryLocationName: $repositoryLocationName
+				repositoryName: $repositoryName
+				pipelineName: $pipelineName
+				assetSelection: $assetSelection
+			}
+			mode: $mode
+			runConfigData: $runConfigData
+		) {
+			__typename
+			... on RunConfigValidationInvalid {
+			errors {
+				...ConfigValidationErrorFragment
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}` + configValidationErrorFragment
+	variables := map[string]any{
+		"repositoryName":         repository.Name,
+		"repositoryLocationName": repository.Location,
+		"pipelineName":           jobName,
+		"assetSelection":         assetSelection,
+		"mode":                   "default",
+		"runConfigData":          strings.Join(runConfigYamlLines, "\n"),
 	}
 
-	var response s.LaunchRunResponse
-	if err := json.Unmarshal([]byte(jsonData), &response); err != nil {
-		log.Fatalf("Failed to parse JSON: %v, %s", err, string(jsonData))
+	var response s.ValidateRunConfigResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
 	}
 
-	return response.Data.LaunchRun.Run.RunId
+	validation := response.IsPipelineConfigValid
+	if validation.TypeName == "RunConfigValidationInvalid" {
+		return validation.Errors, nil
+	}
+	if err := unionError(validation.TypeName, validation.Message, "PipelineConfigValidationValid"); err != nil {
+		return nil, err
+	}
+	return nil, nil
 }
 
-func (c *GraphQLClient) TerminateRun(runId string) s.TerminateRunResponse{
-	re := regexp.MustCompile(`[\s]`)
+// TerminateRun returns the response for a TerminateRunSuccess or TerminateRunFailure, every other type is returned as error
+func (c *GraphQLClient) TerminateRun(ctx context.Context, runId string) (s.TerminateRunResponse, error) {
 	query := `mutation TerminateRun($runId: String!) {
 				terminateRun(runId: $runId){
 					__typename
@@ -250,6 +380,7 @@ func (c *GraphQLClient) TerminateRun(runId string) s.TerminateRunResponse{
 					}
 					... on RunNotFoundError {
 					runId
+					message
 					}
 					... on PythonError {
 					message
@@ -257,65 +388,98 @@ func (c *GraphQLClient) TerminateRun(runId string) s.TerminateRunResponse{
 					}
 				}
 			}`
-
-	query = re.ReplaceAllString(query, " ")
-	formattedQuery := fmt.Sprintf(`{
-		"query": "%s",
-		"variables": { "runId": "%s"}
-	}`, query, runId)
-
-	req, reqErr := http.NewRequest("POST", c.Url, bytes.NewBuffer([]byte(formattedQuery)))
-	if reqErr != nil {
-		panic(reqErr)
+	variables := map[string]any{
+		"runId": runId,
 	}
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
-	client := &http.Client{}
-	resp, respErr := client.Do(req)
-	if respErr != nil {
-		log.Fatalf("Failed POST request: %v", respErr)
+	var response s.TerminateRunResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return s.TerminateRunResponse{}, err
 	}
-	defer resp.Body.Close()
 
-	jsonData, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Fatalf("Failed to read response body: %v", err)
+	terminateRun := response.TerminateRun
+	if err := unionError(terminateRun.TypeName, terminateRun.Message, "TerminateRunSuccess", "TerminateRunFailure"); err != nil {
+		return s.TerminateRunResponse{}, err
 	}
+	return response, nil
+}
 
-	var response s.TerminateRunResponse
-
-	if err := json.Unmarshal([]byte(jsonData), &response); err != nil {
-		log.Fatalf("Failed to parse JSON: %v, %s", err, string(jsonData))
+func (c *GraphQLClient) getLogsPage(ctx context.Context, runId string, afterCursor string, limit int) (s.LogsForRunResponse, error) {
+	query := `query LogsForRunQuery($runId: ID!, $afterCursor: String, $limit: Int) {
+				logsForRun(runId: $runId, afterCursor: $afterCursor, limit: $limit) {
+					__typename
+					... on EventConnection {
+					events {
+						__typename
+						... on MessageEvent {
+						message
+						timestamp
+						level
+						stepKey
+						}
+						... on ExecutionStepFailureEvent {
+						error {
+							message
+						}
+						}
+						... on RunFailureEvent {
+						error {
+							message
+						}
+						}
+						... on LogsCapturedEvent {
+						fileKey
+						stepKeys
+						}
+					}
+					cursor
+					hasMore
+					}
+					... on RunNotFoundError {
+					message
+					}
+					... on PythonError {
+					message
+					}
+				}
+			}`
+	variables := map[string]any{
+		"runId":       runId,
+		"afterCursor": nil,
+		"limit":       limit,
+	}
+	if afterCursor != "" {
+		variables["afterCursor"] = afterCursor
 	}
 
-	if err != nil {
-		log.Fatalf("Failed to read response body: %v", err)
+	var response s.LogsForRunResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return s.LogsForRunResponse{}, err
 	}
-	return response
+	return response, nil
 }
 
-func (c *GraphQLClient) GetLogs(runId string) {
-	// example on how to fetch logs
-	// 	query LogsForRun {
-	//   logsForRun(
-	//     runId: "4c322239-fac1-45e1-bcdb-a0e5a4c27a08"
-	//   ) {
-	//     ...on EventConnection {
-	//       events {
-	//         ...on LogsCapturedEvent {
-	//           logKey
-	//           fileKey
-	//           message
-	//         }
-	//         # ...on LogMessageEvent{
-	//         #   level
-	//         #   timestamp
-	//         #   message
-	//         # }
-	//       }
-	//       cursor
-	//       hasMore
-	//     }
-	//   }
-	// }
+// GetLogs fetches all events of a run that come after the given cursor (empty for the start of the run),
+// following the cursor until dagster reports there is nothing more. The returned cursor can be
+// used to continue where this call left off.
+func (c *GraphQLClient) GetLogs(ctx context.Context, runId string, afterCursor string) ([]s.RunEvent, string, error) {
+	events := make([]s.RunEvent, 0)
+	cursor := afterCursor
+	for {
+		response, err := c.getLogsPage(ctx, runId, cursor, logsPageSize)
+		if err != nil {
+			return nil, afterCursor, err
+		}
+		page := response.LogsForRun
+		if err := unionError(page.TypeName, page.Message, "EventConnection"); err != nil {
+			return nil, afterCursor, err
+		}
+		events = append(events, page.Events...)
+		if page.Cursor != "" {
+			cursor = page.Cursor
+		}
+		if !page.HasMore || len(page.Events) == 0 {
+			return events, cursor, nil
+		}
+	}
 }
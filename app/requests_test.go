@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	s "nl/vdb/dagstertui/internal"
+	"reflect"
+	"testing"
+)
+
+func TestExecuteErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		// checks the type of the returned error, nil when no error is expected
+		check func(error) bool
+	}{
+		{"data", http.StatusOK, `{"data": {"value": 42}}`, nil},
+		{"errors with status 400", http.StatusBadRequest, `{"errors": [{"message": "Cannot query field \"value\" on type \"Query\"."}]}`, isError[*GraphQLError]},
+		{"errors with status 200", http.StatusOK, `{"data": null, "errors": [{"message": "Variable \"$runId\" of required type \"ID!\" was not provided."}]}`, isError[*GraphQLError]},
+		{"status without errors", http.StatusBadGateway, `<html>Bad Gateway</html>`, isError[*HTTPStatusError]},
+		{"json status without errors", http.StatusInternalServerError, `{"data": null}`, isError[*HTTPStatusError]},
+		{"no json", http.StatusOK, `Internal Server Error`, isError[*DecodeError]},
+		{"data of another shape", http.StatusOK, `{"data": {"value": "forty two"}}`, isError[*DecodeError]},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := &GraphQLClient{Url: server.URL}
+			var out struct {
+				Value int `json:"value"`
+			}
+			err := client.Execute(context.Background(), `query ValueQuery { value }`, nil, &out)
+			if test.check == nil {
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+				if out.Value != 42 {
+					t.Errorf("Execute() value = %d, want 42", out.Value)
+				}
+				return
+			}
+			if !test.check(err) {
+				t.Errorf("Execute() error = %#v, wrong type", err)
+			}
+		})
+	}
+}
+
+func TestExecuteTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	client := &GraphQLClient{Url: url}
+	var out struct{}
+	err := client.Execute(context.Background(), `query ValueQuery { value }`, nil, &out)
+	var transportError *TransportError
+	if !errors.As(err, &transportError) {
+		t.Fatalf("Execute() error = %#v, want a TransportError", err)
+	}
+	if transportError.Url != url {
+		t.Errorf("TransportError.Url = %s, want %s", transportError.Url, url)
+	}
+}
+
+func TestExecuteRequest(t *testing.T) {
+	var request s.GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("request body: %v", err)
+		}
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client := &GraphQLClient{Url: server.URL}
+	variables := map[string]any{"runId": "abc \"quoted\"", "limit": 10}
+	var out struct{}
+	if err := client.Execute(context.Background(), `query LogsQuery($runId: ID!) { logsForRun(runId: $runId) }`, variables, &out); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if request.OperationName != "LogsQuery" {
+		t.Errorf("operationName = %q, want LogsQuery", request.OperationName)
+	}
+	want := map[string]any{"runId": "abc \"quoted\"", "limit": float64(10)}
+	if !reflect.DeepEqual(request.Variables, want) {
+		t.Errorf("variables = %v, want %v", request.Variables, want)
+	}
+}
+
+// isError returns whether err is or wraps an error of type E
+func isError[E error](err error) bool {
+	var target E
+	return errors.As(err, &target)
+}
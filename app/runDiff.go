@@ -0,0 +1,236 @@
+package app
+
+import (
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"sort"
+	"strings"
+)
+
+// diffLine is a line of a diff, op is ' ' for a line both sides have, '-' for a removed and '+' for an added line
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// runDiffState holds the runs marked for a diff and how the diff is shown, only touched from the gocui main loop
+type runDiffState struct {
+	runs        []s.RunRepresentation
+	sideBySide  bool
+	older       s.RunRepresentation
+	newer       s.RunRepresentation
+	diffedLines []diffLine
+}
+
+var runDiff = &runDiffState{}
+
+func diffMarker(runId string) string {
+	for _, run := range runDiff.runs {
+		if run.RunId == runId {
+			return " \t (diff)"
+		}
+	}
+	return ""
+}
+
+// diffLines returns the lines of b compared to a, based on their longest common subsequence
+func diffLines(a []string, b []string) []diffLine {
+	// common[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+	return lines
+}
+
+// runConfigWithTags returns the lines compared for a run, the run config followed by the sorted tags
+func runConfigWithTags(run s.RunRepresentation) []string {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(run.RunconfigYaml, "\t", "    "), "\n"), "\n")
+	tags := formatTags(run.Tags)
+	sort.Strings(tags)
+	lines = append(lines, "", "# tags")
+	return append(lines, tags...)
+}
+
+func colorDiffLine(op byte, text string) string {
+	switch op {
+	case '-':
+		return fmt.Sprintf("\x1b[31m%s\x1b[0m", text)
+	case '+':
+		return fmt.Sprintf("\x1b[32m%s\x1b[0m", text)
+	default:
+		return text
+	}
+}
+
+func renderUnifiedDiff(lines []diffLine) []string {
+	rendered := []string{
+		colorDiffLine('-', fmt.Sprintf("--- %s", runDiff.older.RunId)),
+		colorDiffLine('+', fmt.Sprintf("+++ %s", runDiff.newer.RunId)),
+	}
+	for _, line := range lines {
+		rendered = append(rendered, colorDiffLine(line.op, fmt.Sprintf("%c %s", line.op, line.text)))
+	}
+	return rendered
+}
+
+// fitColumn cuts text off or pads it with spaces to width runes
+func fitColumn(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) > width {
+		return string(runes[:width])
+	}
+	return text + strings.Repeat(" ", width-len(runes))
+}
+
+// renderSideBySideDiff shows the older run on the left and the newer on the right, removed and added lines
+// next to each other are put on the same row
+func renderSideBySideDiff(lines []diffLine, width int) []string {
+	column := (width - 3) / 2
+	if column < 1 {
+		column = 1
+	}
+	// op '~' puts a removed line next to an added one
+	row := func(op byte, left string, right string) string {
+		leftOp, rightOp := op, op
+		if op == '~' {
+			leftOp, rightOp = '-', '+'
+		}
+		return fmt.Sprintf("%s | %s", colorDiffLine(leftOp, fitColumn(left, column)), colorDiffLine(rightOp, fitColumn(right, column)))
+	}
+
+	rendered := []string{row('~', runDiff.older.RunId, runDiff.newer.RunId)}
+	for index := 0; index < len(lines); {
+		if lines[index].op == ' ' {
+			rendered = append(rendered, row(' ', lines[index].text, lines[index].text))
+			index++
+			continue
+		}
+		removed, added := make([]string, 0), make([]string, 0)
+		for ; index < len(lines) && lines[index].op != ' '; index++ {
+			if lines[index].op == '-' {
+				removed = append(removed, lines[index].text)
+			} else {
+				added = append(added, lines[index].text)
+			}
+		}
+		for k := 0; k < len(removed) || k < len(added); k++ {
+			left, right := "", ""
+			if k < len(removed) {
+				left = removed[k]
+			}
+			if k < len(added) {
+				right = added[k]
+			}
+			rendered = append(rendered, row('~', left, right))
+		}
+	}
+	return rendered
+}
+
+func renderRunDiff() {
+	mode := "unified"
+	if runDiff.sideBySide {
+		mode = "side by side"
+	}
+	RunDiffWindow.Base.SetTitle(fmt.Sprintf("Run config and tags of %s vs %s - %s, s to switch", runDiff.older.RunId, runDiff.newer.RunId, mode))
+
+	if runDiff.sideBySide {
+		width, _ := RunDiffWindow.Base.View.Size()
+		RunDiffWindow.RenderContent(renderSideBySideDiff(runDiff.diffedLines, width))
+	} else {
+		RunDiffWindow.RenderContent(renderUnifiedDiff(runDiff.diffedLines))
+	}
+}
+
+// ToggleRunDiffMark marks the run under the cursor in RUNS_VIEW for a diff, the diff opens once two runs are marked
+func ToggleRunDiffMark(g *c.Gui, v *c.View) error {
+	run, ok := RunsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	for index, marked := range runDiff.runs {
+		if marked.RunId == run.RunId {
+			runDiff.runs = append(runDiff.runs[:index], runDiff.runs[index+1:]...)
+			RunsWindow.RefreshItems()
+			return nil
+		}
+	}
+	runDiff.runs = append(runDiff.runs, run)
+	RunsWindow.RefreshItems()
+	if len(runDiff.runs) < 2 {
+		showStatus(fmt.Sprintf("Marked run %s, mark another run with d to compare", run.RunId))
+		return nil
+	}
+	return openRunDiffWindow(g, v)
+}
+
+func openRunDiffWindow(g *c.Gui, v *c.View) error {
+	older, newer := runDiff.runs[0], runDiff.runs[1]
+	if newer.StartTime < older.StartTime {
+		older, newer = newer, older
+	}
+	runDiff.older, runDiff.newer = older, newer
+	runDiff.diffedLines = diffLines(runConfigWithTags(older), runConfigWithTags(newer))
+
+	maxX, maxY := g.Size()
+	RunDiffWindow.Initialize(g, "", RUN_DIFF_VIEW)
+	RunDiffWindow.Base.RenderView(g, int(float64(maxX)*0.05), int(float64(maxY)*0.05), int(float64(maxX)*0.95), int(float64(maxY)*0.95))
+	RunDiffWindow.Base.SetNavigableFeedback(g)
+	RunDiffWindow.Base.View.Wrap = false
+	RunDiffWindow.Base.View.Highlight = true
+	renderRunDiff()
+
+	return State.SetNewActiveWindow(g, v.Name(), RUN_DIFF_VIEW)
+}
+
+// ToggleRunDiffLayout switches between the unified and the side by side diff
+func ToggleRunDiffLayout(g *c.Gui, v *c.View) error {
+	runDiff.sideBySide = !runDiff.sideBySide
+	renderRunDiff()
+	v.SetOrigin(0, 0)
+	v.SetCursor(0, 0)
+	return nil
+}
+
+// CloseRunDiffWindow closes the diff and unmarks the compared runs
+func CloseRunDiffWindow(g *c.Gui, v *c.View) error {
+	runDiff.runs = nil
+	RunsWindow.RefreshItems()
+	return ClosePopupView(g, v)
+}
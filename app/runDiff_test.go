@@ -0,0 +1,32 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want []diffLine
+	}{
+		{"both empty", nil, nil, []diffLine{}},
+		{"added to empty", nil, []string{"x", "y"}, []diffLine{{'+', "x"}, {'+', "y"}}},
+		{"removed to empty", []string{"x", "y"}, nil, []diffLine{{'-', "x"}, {'-', "y"}}},
+		{"identical", []string{"x", "y"}, []string{"x", "y"}, []diffLine{{' ', "x"}, {' ', "y"}}},
+		{"all changed", []string{"x", "y"}, []string{"p", "q"}, []diffLine{{'-', "x"}, {'-', "y"}, {'+', "p"}, {'+', "q"}}},
+		{"changed line in the middle", []string{"x", "y", "z"}, []string{"x", "q", "z"}, []diffLine{{' ', "x"}, {'-', "y"}, {'+', "q"}, {' ', "z"}}},
+		{"inserted line", []string{"x", "z"}, []string{"x", "y", "z"}, []diffLine{{' ', "x"}, {'+', "y"}, {' ', "z"}}},
+		{"removed line", []string{"x", "y", "z"}, []string{"x", "z"}, []diffLine{{' ', "x"}, {'-', "y"}, {' ', "z"}}},
+		{"repeated lines", []string{"x", "x", "y"}, []string{"x", "y", "y"}, []diffLine{{' ', "x"}, {'-', "x"}, {' ', "y"}, {'+', "y"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := diffLines(test.a, test.b); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("diffLines(%q, %q) = %q, want %q", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package app
+
+import (
+	"context"
+	s "nl/vdb/dagstertui/internal"
+)
+
+func (c *GraphQLClient) GetStepStats(ctx context.Context, runId string) ([]s.StepStats, error) {
+	query := `query RunStepStatsQuery($runId: ID!) {
+		runOrError(runId: $runId) {
+			__typename
+			... on Run {
+			runId
+			status
+			stepStats {
+				stepKey
+				status
+				startTime
+				endTime
+				attempts {
+					startTime
+					endTime
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"runId": runId,
+	}
+
+	var response s.RunStepStatsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	run := response.RunOrError
+	if err := unionError(run.TypeName, run.Message, "Run"); err != nil {
+		return nil, err
+	}
+	return run.StepStats, nil
+}
+
+// ReexecuteRun launches a re-execution of the parent run, strategy is FROM_FAILURE or ALL_STEPS
+func (c *GraphQLClient) ReexecuteRun(ctx context.Context, parentRunId string, strategy string) (string, error) {
+	query := `mutation LaunchReexecutionMutation($reexecutionParams: ReexecutionParams) {
+		launchPipelineReexecution(reexecutionParams: $reexecutionParams) {
+			__typename
+			... on LaunchRunSuccess {
+			run {
+				runId
+			}
+			}
+			... on RunConfigValidationInvalid {
+			errors {
+				...ConfigValidationErrorFragment
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}` + configValidationErrorFragment
+	variables := map[string]any{
+		"reexecutionParams": map[string]any{
+			"parentRunId": parentRunId,
+			"strategy":    strategy,
+		},
+	}
+
+	var response s.LaunchReexecutionResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return "", err
+	}
+
+	reexecution := response.LaunchPipelineReexecution
+	if reexecution.TypeName == "RunConfigValidationInvalid" {
+		return "", &RunConfigValidationError{Errors: reexecution.Errors}
+	}
+	if err := unionError(reexecution.TypeName, reexecution.Message, "LaunchRunSuccess"); err != nil {
+		return "", err
+	}
+	return reexecution.Run.RunId, nil
+}
+
+// GetExecutionPlan returns the steps the job executes with the run config, including the steps each step depends on
+func (c *GraphQLClient) GetExecutionPlan(ctx context.Context, repository s.RepositoryRepresentation, jobName string, runConfigYaml string) ([]s.ExecutionStep, error) {
+	query := `query ExecutionPlanQuery(
+		$pipeline: PipelineSelector!
+		$runConfigData: RunConfigData
+		$mode: String!
+	) {
+		executionPlanOrError(pipeline: $pipeline, runConfigData: $runConfigData, mode: $mode) {
+			__typename
+			... on ExecutionPlan {
+			steps {
+				key
+				inputs {
+					dependsOn {
+						key
+					}
+				}
+			}
+			}
+			... on RunConfigValidationInvalid {
+			errors {
+				...ConfigValidationErrorFragment
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}` + configValidationErrorFragment
+	variables := map[string]any{
+		"pipeline": map[string]any{
+			"repositoryName":         repository.Name,
+			"repositoryLocationName": repository.Location,
+			"pipelineName":           jobName,
+		},
+		"runConfigData": runConfigYaml,
+		"mode":          "default",
+	}
+
+	var response s.ExecutionPlanResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	plan := response.ExecutionPlanOrError
+	if plan.TypeName == "RunConfigValidationInvalid" {
+		return nil, &RunConfigValidationError{Errors: plan.Errors}
+	}
+	if err := unionError(plan.TypeName, plan.Message, "ExecutionPlan"); err != nil {
+		return nil, err
+	}
+	return plan.Steps, nil
+}
+
+// ReexecuteSteps launches a re-execution of the parent run limited to the step keys, stepSelection is the
+// selection query the step keys got resolved from
+func (c *GraphQLClient) ReexecuteSteps(ctx context.Context, repository s.RepositoryRepresentation, jobName string, parent s.RunRepresentation, stepKeys []string, stepSelection string) (string, error) {
+	query := `mutation LaunchStepReexecutionMutation($executionParams: ExecutionParams) {
+		launchPipelineReexecution(executionParams: $executionParams) {
+			__typename
+			... on LaunchRunSuccess {
+			run {
+				runId
+			}
+			}
+			... on RunConfigValidationInvalid {
+			errors {
+				...ConfigValidationErrorFragment
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}` + configValidationErrorFragment
+
+	rootRunId := parent.RunId
+	tags := make([]s.Tag, 0)
+	for _, tag := range parent.Tags {
+		switch tag.Key {
+		case "dagster/root_run_id":
+			rootRunId = tag.Value
+		case "dagster/parent_run_id", "dagster/step_selection", "dagster/is_resume_retry":
+		default:
+			tags = append(tags, tag)
+		}
+	}
+	tags = append(tags,
+		s.Tag{Key: "dagster/parent_run_id", Value: parent.RunId},
+		s.Tag{Key: "dagster/root_run_id", Value: rootRunId},
+		s.Tag{Key: "dagster/step_selection", Value: stepSelection})
+
+	variables := map[string]any{
+		"executionParams": map[string]any{
+			"selector": map[string]any{
+				"repositoryName":         repository.Name,
+				"repositoryLocationName": repository.Location,
+				"jobName":                jobName,
+			},
+			"runConfigData": parent.RunconfigYaml,
+			"mode":          "default",
+			"stepKeys":      stepKeys,
+			"executionMetadata": map[string]any{
+				"parentRunId": parent.RunId,
+				"rootRunId":   rootRunId,
+				"tags":        tags,
+			},
+		},
+	}
+
+	var response s.LaunchReexecutionResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return "", err
+	}
+
+	reexecution := response.LaunchPipelineReexecution
+	if reexecution.TypeName == "RunConfigValidationInvalid" {
+		return "", &RunConfigValidationError{Errors: reexecution.Errors}
+	}
+	if err := unionError(reexecution.TypeName, reexecution.Message, "LaunchRunSuccess"); err != nil {
+		return "", err
+	}
+	return reexecution.Run.RunId, nil
+}
+
+// SearchRuns returns the runs of all jobs matching the RunsFilter, cursor is the id of the last run of the previous
+// page (empty for the first page)
+func (c *GraphQLClient) SearchRuns(ctx context.Context, filter map[string]any, cursor string, limit int) ([]s.Run, error) {
+	query := `query SearchRunsQuery($filter: RunsFilter, $cursor: String, $limit: Int) {
+		runsOrError(filter: $filter, cursor: $cursor, limit: $limit) {
+			__typename
+			... on Runs {
+			results {
+				runId
+				jobName
+				status
+				startTime
+				endTime
+				repositoryOrigin {
+					repositoryLocationName
+					repositoryName
+				}
+				tags {
+					key
+					value
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"filter": filter,
+		"cursor": nil,
+		"limit":  limit,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	var response s.SearchRunsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	runs := response.RunsOrError
+	if err := unionError(runs.TypeName, runs.Message, "Runs"); err != nil {
+		return nil, err
+	}
+	return runs.Results, nil
+}
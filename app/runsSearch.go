@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+	"time"
+)
+
+const runsSearchPageSize = 50
+
+const runsSearchHelp = "status:FAILURE,CANCELED job:name tag:key=value id:runId after:12h before:2006-01-02T15:04"
+
+// runsSearchState holds the filter of the last search and whether all of its runs are loaded, pending is set
+// until the first page of the search arrived since the runs shown until then belong to the previous search
+type runsSearchState struct {
+	query    string
+	filter   map[string]any
+	complete bool
+	pending  bool
+}
+
+var runsSearch = &runsSearchState{}
+
+var runsSearchTimeLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"}
+
+// parseFilterTime reads an absolute local time, or a duration like 12h meaning that long ago
+func parseFilterTime(value string, now time.Time) (float64, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return float64(now.Add(-duration).Unix()), nil
+	}
+	for _, layout := range runsSearchTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return float64(t.Unix()), nil
+		}
+	}
+	return 0, fmt.Errorf("Invalid time %s, use a duration like 12h or a time like 2006-01-02T15:04", value)
+}
+
+// parseRunsFilter translates space separated key:value terms into a dagster RunsFilter
+func parseRunsFilter(query string, now time.Time) (map[string]any, error) {
+	filter := make(map[string]any)
+	tags := make([]s.Tag, 0)
+	for _, term := range strings.Fields(query) {
+		key, value, found := strings.Cut(term, ":")
+		if !found || value == "" {
+			return nil, fmt.Errorf("Search term %s is not of the form key:value, e.g. %s", term, runsSearchHelp)
+		}
+		switch key {
+		case "status":
+			filter["statuses"] = strings.Split(strings.ToUpper(value), ",")
+		case "job":
+			filter["pipelineName"] = value
+		case "id":
+			filter["runIds"] = strings.Split(value, ",")
+		case "tag":
+			tagKey, tagValue, found := strings.Cut(value, "=")
+			if !found {
+				return nil, fmt.Errorf("Tag %s is not of the form key=value", value)
+			}
+			tags = append(tags, s.Tag{Key: tagKey, Value: tagValue})
+		case "after", "before":
+			timestamp, err := parseFilterTime(value, now)
+			if err != nil {
+				return nil, err
+			}
+			if key == "after" {
+				filter["createdAfter"] = timestamp
+			} else {
+				filter["createdBefore"] = timestamp
+			}
+		default:
+			return nil, fmt.Errorf("Unknown search term %s, use %s", key, runsSearchHelp)
+		}
+	}
+	if len(tags) > 0 {
+		filter["tags"] = tags
+	}
+	return filter, nil
+}
+
+func formatRunStatus(status string) string {
+	padded := fmt.Sprintf("%-10s", status)
+	switch status {
+	case "SUCCESS":
+		return fmt.Sprintf("\x1b[32m%s\x1b[0m", padded)
+	case "FAILURE", "CANCELED":
+		return fmt.Sprintf("\x1b[31m%s\x1b[0m", padded)
+	case "STARTED", "STARTING", "QUEUED", "CANCELING":
+		return fmt.Sprintf("\x1b[33m%s\x1b[0m", padded)
+	default:
+		return padded
+	}
+}
+
+func formatSearchedRun(run s.Run) string {
+	return fmt.Sprintf("%s  %s  %s  %s \t %s", formatUnixSeconds(run.StartTime), formatRunStatus(run.Status), run.RunId, run.JobName, run.RepositoryOrigin.RepositoryLocationName)
+}
+
+func setRunsSearchTitle() {
+	title := fmt.Sprintf("Runs - %d loaded", len(RunsSearchWindow.RawElements))
+	if runsSearch.complete {
+		title = fmt.Sprintf("%s (all)", title)
+	} else {
+		title = fmt.Sprintf("%s - n to load more", title)
+	}
+	RunsSearchWindow.Base.SetTitle(title)
+}
+
+// loadSearchedRuns fetches the page of runs after cursor, an empty cursor starts a new search
+func loadSearchedRuns(g *c.Gui, cursor string) {
+	filter := runsSearch.filter
+	FetchAsync(g, RUNS_SEARCH_VIEW, RunsSearchWindow.Base,
+		func(ctx context.Context) ([]s.Run, error) {
+			return Client.SearchRuns(ctx, filter, cursor, runsSearchPageSize)
+		},
+		func(g *c.Gui, runs []s.Run) error {
+			// the search got closed while loading
+			if _, err := g.View(RUNS_SEARCH_VIEW); err != nil {
+				return nil
+			}
+			runsSearch.complete = len(runs) < runsSearchPageSize
+			if cursor == "" {
+				runsSearch.pending = false
+				RunsSearchWindow.RenderItems(runs, false)
+				RunsSearchWindow.ResetCursor()
+			} else {
+				ox, oy := RunsSearchWindow.Base.View.Origin()
+				x, y := RunsSearchWindow.Base.View.Cursor()
+				RunsSearchWindow.RenderItems(append(RunsSearchWindow.RawElements, runs...), false)
+				RunsSearchWindow.Base.View.SetOrigin(ox, oy)
+				RunsSearchWindow.Base.View.SetCursor(x, y)
+			}
+			setRunsSearchTitle()
+			return nil
+		})
+}
+
+// SearchRuns starts a search with the filter typed into the filter line
+func SearchRuns(g *c.Gui, v *c.View) error {
+	query := strings.TrimSpace(strings.Join(RunsFilterView.Base.View.BufferLines(), " "))
+	filter, err := parseRunsFilter(query, time.Now())
+	if err != nil {
+		return OpenErrorWindow(g, err)
+	}
+	runsSearch.query = query
+	runsSearch.filter = filter
+	runsSearch.complete = false
+	runsSearch.pending = true
+	loadSearchedRuns(g, "")
+	_, err = g.SetCurrentView(RUNS_SEARCH_VIEW)
+	return err
+}
+
+// LoadMoreSearchedRuns fetches the next page of runs of the current search
+func LoadMoreSearchedRuns(g *c.Gui, v *c.View) error {
+	if runsSearch.complete || runsSearch.pending || len(RunsSearchWindow.RawElements) == 0 {
+		return nil
+	}
+	loadSearchedRuns(g, RunsSearchWindow.RawElements[len(RunsSearchWindow.RawElements)-1].RunId)
+	return nil
+}
+
+// SwitchRunsSearchPane toggles the focus between the filter line and the results
+func SwitchRunsSearchPane(g *c.Gui, v *c.View) error {
+	next := RUNS_SEARCH_VIEW
+	if v.Name() == RUNS_SEARCH_VIEW {
+		next = SEARCH_FILTER
+	}
+	_, err := g.SetCurrentView(next)
+	return err
+}
+
+func OpenRunsSearch(g *c.Gui, v *c.View) error {
+	if _, err := g.View(RUNS_SEARCH_VIEW); err == nil {
+		return nil
+	}
+	maxX, maxY := g.Size()
+	startX, endX := int(float64(maxX)*0.05), int(float64(maxX)*0.95)
+	filterY := int(float64(maxY) * 0.05)
+
+	RunsFilterView.Initialize(g, fmt.Sprintf("Search runs - %s", runsSearchHelp), SEARCH_FILTER)
+	RunsFilterView.Base.RenderView(g, startX, filterY, endX, filterY+2)
+	RunsFilterView.Base.View.Editable = true
+	RunsFilterView.Base.View.Editor = DefaultEditor
+	RunsFilterView.RenderContent([]string{runsSearch.query})
+	RunsFilterView.Base.View.SetCursor(len(runsSearch.query), 0)
+
+	RunsSearchWindow.Initialize(g, "Runs", RUNS_SEARCH_VIEW, formatSearchedRun, func(run s.Run) string { return run.RunId })
+	RunsSearchWindow.Base.RenderView(g, startX, filterY+3, endX, int(float64(maxY)*0.95))
+	RunsSearchWindow.Base.SetNavigableFeedback(g)
+	RunsSearchWindow.Base.View.Wrap = false
+
+	returnWindow := REPOSITORIES_VIEW
+	if v != nil {
+		returnWindow = v.Name()
+	}
+	return State.SetNewActiveWindow(g, returnWindow, SEARCH_FILTER)
+}
+
+func CloseRunsSearch(g *c.Gui, v *c.View) error {
+	if err := g.DeleteView(RUNS_SEARCH_VIEW); err != nil {
+		return err
+	}
+	return ClosePopupView(g, RunsFilterView.Base.View)
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	s "nl/vdb/dagstertui/internal"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRunsFilter(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name  string
+		query string
+		want  map[string]any
+		// part of the error message, empty when no error is expected
+		wantErr string
+	}{
+		{"empty query", "", map[string]any{}, ""},
+		{"statuses are upper cased", "status:failure,CANCELED", map[string]any{"statuses": []string{"FAILURE", "CANCELED"}}, ""},
+		{"job", "job:my_job", map[string]any{"pipelineName": "my_job"}, ""},
+		{"run ids", "id:abc,def", map[string]any{"runIds": []string{"abc", "def"}}, ""},
+		{"tags", "tag:team=data tag:env=prod", map[string]any{"tags": []s.Tag{{Key: "team", Value: "data"}, {Key: "env", Value: "prod"}}}, ""},
+		{"tag value with =", "tag:expr=a=b", map[string]any{"tags": []s.Tag{{Key: "expr", Value: "a=b"}}}, ""},
+		{"after a duration ago", "after:12h", map[string]any{"createdAfter": float64(now.Add(-12 * time.Hour).Unix())}, ""},
+		{"before a time", "before:2024-03-01T08:30", map[string]any{"createdBefore": float64(time.Date(2024, 3, 1, 8, 30, 0, 0, time.Local).Unix())}, ""},
+		{"after a date", "after:2024-03-01", map[string]any{"createdAfter": float64(time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local).Unix())}, ""},
+		{"terms combined", "job:my_job  status:SUCCESS", map[string]any{"pipelineName": "my_job", "statuses": []string{"SUCCESS"}}, ""},
+		{"term without value", "status:", nil, "not of the form key:value"},
+		{"term without key", "my_job", nil, "not of the form key:value"},
+		{"unknown key", "owner:me", nil, "Unknown search term owner"},
+		{"tag without =", "tag:team", nil, "Tag team is not of the form key=value"},
+		{"invalid time", "after:yesterday", nil, "Invalid time yesterday"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseRunsFilter(test.query, now)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("parseRunsFilter(%q) error = %v, want it to contain %q", test.query, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRunsFilter(%q) error = %v", test.query, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseRunsFilter(%q) = %v, want %v", test.query, got, test.want)
+			}
+		})
+	}
+}
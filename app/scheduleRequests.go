@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	s "nl/vdb/dagstertui/internal"
+)
+
+func (c *GraphQLClient) GetSchedules(ctx context.Context, repository s.RepositoryRepresentation) ([]s.Schedule, error) {
+	query := `query SchedulesQuery($repositorySelector: RepositorySelector!) {
+		schedulesOrError(repositorySelector: $repositorySelector) {
+			__typename
+			... on Schedules {
+			results {
+				name
+				cronSchedule
+				executionTimezone
+				pipelineName
+				scheduleState {
+					id
+					selectorId
+					status
+					ticks(limit: 1) {
+						status
+						timestamp
+						skipReason
+						runIds
+						error {
+							message
+						}
+					}
+				}
+				futureTicks(limit: 1) {
+					results {
+						timestamp
+					}
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"repositorySelector": map[string]any{
+			"repositoryName":         repository.Name,
+			"repositoryLocationName": repository.Location,
+		},
+	}
+
+	var response s.SchedulesResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	schedules := response.SchedulesOrError
+	if err := unionError(schedules.TypeName, schedules.Message, "Schedules"); err != nil {
+		return nil, err
+	}
+	return schedules.Results, nil
+}
+
+func (c *GraphQLClient) StartSchedule(ctx context.Context, repository s.RepositoryRepresentation, scheduleName string) error {
+	query := `mutation StartScheduleMutation($scheduleSelector: ScheduleSelector!) {
+		startSchedule(scheduleSelector: $scheduleSelector) {
+			__typename
+			... on ScheduleStateResult {
+			scheduleState {
+				status
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"scheduleSelector": map[string]any{
+			"repositoryName":         repository.Name,
+			"repositoryLocationName": repository.Location,
+			"scheduleName":           scheduleName,
+		},
+	}
+
+	var response s.StartScheduleResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	result := response.StartSchedule
+	return unionError(result.TypeName, result.Message, "ScheduleStateResult")
+}
+
+func (c *GraphQLClient) StopSchedule(ctx context.Context, schedule s.Schedule) error {
+	query := `mutation StopScheduleMutation($scheduleOriginId: String!, $scheduleSelectorId: String!) {
+		stopRunningSchedule(scheduleOriginId: $scheduleOriginId, scheduleSelectorId: $scheduleSelectorId) {
+			__typename
+			... on ScheduleStateResult {
+			scheduleState {
+				status
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"scheduleOriginId":   schedule.ScheduleState.Id,
+		"scheduleSelectorId": schedule.ScheduleState.SelectorId,
+	}
+
+	var response s.StopScheduleResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	result := response.StopRunningSchedule
+	return unionError(result.TypeName, result.Message, "ScheduleStateResult")
+}
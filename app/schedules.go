@@ -0,0 +1,152 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+	"time"
+)
+
+// schedulesRepo is the repository the schedules view got opened for
+var schedulesRepo s.RepositoryRepresentation
+
+func formatUnixSeconds(timestamp float64) string {
+	if timestamp == 0 {
+		return "-"
+	}
+	return time.Unix(int64(timestamp), 0).Local().Format("2006-01-02 15:04")
+}
+
+func formatInstigationStatus(status string) string {
+	padded := fmt.Sprintf("%-8s", status)
+	if status == "RUNNING" {
+		return fmt.Sprintf("\x1b[32m%s\x1b[0m", padded)
+	}
+	return fmt.Sprintf("\x1b[33m%s\x1b[0m", padded)
+}
+
+// formatTickResult describes the outcome of a schedule or sensor tick in a single line
+func formatTickResult(tick s.InstigationTick) string {
+	result := fmt.Sprintf("%s %s", formatUnixSeconds(tick.Timestamp), tick.Status)
+	switch {
+	case tick.Error != nil && tick.Error.Message != "":
+		message, _, _ := strings.Cut(tick.Error.Message, "\n")
+		return fmt.Sprintf("\x1b[31m%s: %s\x1b[0m", result, message)
+	case tick.SkipReason != "":
+		return fmt.Sprintf("%s: %s", result, tick.SkipReason)
+	case len(tick.RunIds) > 0:
+		return fmt.Sprintf("%s: %d run(s)", result, len(tick.RunIds))
+	default:
+		return result
+	}
+}
+
+func formatSchedule(schedule s.Schedule) string {
+	nextTick := "-"
+	if len(schedule.FutureTicks.Results) > 0 && schedule.ScheduleState.Status == "RUNNING" {
+		nextTick = formatUnixSeconds(schedule.FutureTicks.Results[0].Timestamp)
+	}
+	lastTick := "no ticks"
+	if len(schedule.ScheduleState.Ticks) > 0 {
+		lastTick = formatTickResult(schedule.ScheduleState.Ticks[0])
+	}
+	return fmt.Sprintf("%s  %s \t %s (%s) \t next: %s \t last: %s",
+		formatInstigationStatus(schedule.ScheduleState.Status), schedule.Name, schedule.CronSchedule, schedule.ExecutionTimezone, nextTick, lastTick)
+}
+
+// selectedRepository returns the repository under the cursor when v is REPOSITORIES_VIEW, otherwise the
+// repository the jobs got loaded for
+func selectedRepository(v *c.View) (s.RepositoryRepresentation, error) {
+	location := State.SelectedRepo
+	if v != nil && v.Name() == REPOSITORIES_VIEW {
+		location = RepoWindow.GetElementOnCursorPosition()
+	}
+	if _, ok := Overview.Repositories[location]; !ok || location == "" {
+		return s.RepositoryRepresentation{}, fmt.Errorf("No repository selected")
+	}
+	return Overview.GetRepoByLocation(location), nil
+}
+
+func LoadSchedules(g *c.Gui) {
+	repo := schedulesRepo
+	FetchAsync(g, SCHEDULES_VIEW, SchedulesWindow.Base,
+		func(ctx context.Context) ([]s.Schedule, error) {
+			return Client.GetSchedules(ctx, repo)
+		},
+		func(g *c.Gui, schedules []s.Schedule) error {
+			// the schedules window got closed while loading
+			if _, err := g.View(SCHEDULES_VIEW); err != nil {
+				return nil
+			}
+			selected, _ := SchedulesWindow.GetRawElementOnCursorPosition()
+			SchedulesWindow.RenderItems(schedules)
+			if !SchedulesWindow.SelectElement(func(schedule s.Schedule) bool { return schedule.Name == selected.Name }) {
+				SchedulesWindow.ResetCursor()
+			}
+			return nil
+		})
+}
+
+func ReloadSchedules(g *c.Gui, v *c.View) error {
+	LoadSchedules(g)
+	return nil
+}
+
+func OpenSchedulesWindow(g *c.Gui, v *c.View) error {
+	repo, err := selectedRepository(v)
+	if err != nil {
+		return OpenErrorWindow(g, err)
+	}
+	schedulesRepo = repo
+	maxX, maxY := g.Size()
+
+	SchedulesWindow.Initialize(g, fmt.Sprintf("Schedules of %s", repo.Location), SCHEDULES_VIEW,
+		formatSchedule, func(schedule s.Schedule) string { return schedule.Name })
+	SchedulesWindow.Base.RenderView(g, int(float64(maxX)*0.1), int(float64(maxY)*0.1), int(float64(maxX)*0.9), int(float64(maxY)*0.9))
+	SchedulesWindow.Base.SetNavigableFeedback(g)
+	SchedulesWindow.Base.View.Wrap = false
+	LoadSchedules(g)
+
+	return State.SetNewActiveWindow(g, v.Name(), SCHEDULES_VIEW)
+}
+
+// setScheduleRunning starts or stops the schedule under the cursor after confirmation
+func setScheduleRunning(g *c.Gui, v *c.View, running bool) error {
+	schedule, ok := SchedulesWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	if (schedule.ScheduleState.Status == "RUNNING") == running {
+		return nil
+	}
+	repo := schedulesRepo
+
+	action := "Stop"
+	if running {
+		action = "Start"
+	}
+	return AskConfirmation(g, v, fmt.Sprintf("%s schedule %s?", action, schedule.Name), func(g *c.Gui) error {
+		MutateAsync(g, "schedule_state", SchedulesWindow.Base,
+			func(ctx context.Context) (struct{}, error) {
+				if running {
+					return struct{}{}, Client.StartSchedule(ctx, repo, schedule.Name)
+				}
+				return struct{}{}, Client.StopSchedule(ctx, schedule)
+			},
+			func(g *c.Gui, _ struct{}) error {
+				LoadSchedules(g)
+				return nil
+			})
+		return nil
+	})
+}
+
+func StartSchedule(g *c.Gui, v *c.View) error {
+	return setScheduleRunning(g, v, true)
+}
+
+func StopSchedule(g *c.Gui, v *c.View) error {
+	return setScheduleRunning(g, v, false)
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	s "nl/vdb/dagstertui/internal"
+)
+
+func (c *GraphQLClient) GetSensors(ctx context.Context, repository s.RepositoryRepresentation, tickLimit int) ([]s.Sensor, error) {
+	query := `query SensorsQuery($repositorySelector: RepositorySelector!, $tickLimit: Int) {
+		sensorsOrError(repositorySelector: $repositorySelector) {
+			__typename
+			... on Sensors {
+			results {
+				name
+				sensorType
+				minIntervalSeconds
+				sensorState {
+					id
+					selectorId
+					status
+					typeSpecificData {
+						... on SensorData {
+						lastCursor
+						}
+					}
+					ticks(limit: $tickLimit) {
+						status
+						timestamp
+						skipReason
+						runIds
+						cursor
+						error {
+							message
+						}
+					}
+				}
+			}
+			}
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"repositorySelector": map[string]any{
+			"repositoryName":         repository.Name,
+			"repositoryLocationName": repository.Location,
+		},
+		"tickLimit": tickLimit,
+	}
+
+	var response s.SensorsResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	sensors := response.SensorsOrError
+	if err := unionError(sensors.TypeName, sensors.Message, "Sensors"); err != nil {
+		return nil, err
+	}
+	return sensors.Results, nil
+}
+
+func (c *GraphQLClient) StartSensor(ctx context.Context, repository s.RepositoryRepresentation, sensorName string) error {
+	query := `mutation StartSensorMutation($sensorSelector: SensorSelector!) {
+		startSensor(sensorSelector: $sensorSelector) {
+			__typename
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"sensorSelector": map[string]any{
+			"repositoryName":         repository.Name,
+			"repositoryLocationName": repository.Location,
+			"sensorName":             sensorName,
+		},
+	}
+
+	var response s.StartSensorResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	result := response.StartSensor
+	return unionError(result.TypeName, result.Message, "Sensor")
+}
+
+func (c *GraphQLClient) StopSensor(ctx context.Context, sensor s.Sensor) error {
+	query := `mutation StopSensorMutation($jobOriginId: String!, $jobSelectorId: String!) {
+		stopSensor(jobOriginId: $jobOriginId, jobSelectorId: $jobSelectorId) {
+			__typename
+			... on Error {
+			message
+			}
+		}
+	}`
+	variables := map[string]any{
+		"jobOriginId":   sensor.SensorState.Id,
+		"jobSelectorId": sensor.SensorState.SelectorId,
+	}
+
+	var response s.StopSensorResponse
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	result := response.StopSensor
+	return unionError(result.TypeName, result.Message, "StopSensorMutationResult")
+}
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+)
+
+// number of ticks shown for the selected sensor
+const sensorTicksLimit = 10
+
+// sensorsRepo is the repository the sensors view got opened for
+var sensorsRepo s.RepositoryRepresentation
+
+func formatSensor(sensor s.Sensor) string {
+	lastTick := "no ticks"
+	if len(sensor.SensorState.Ticks) > 0 {
+		lastTick = formatTickResult(sensor.SensorState.Ticks[0])
+	}
+	return fmt.Sprintf("%s  %s \t %s every %ds \t last: %s",
+		formatInstigationStatus(sensor.SensorState.Status), sensor.Name, sensor.SensorType, sensor.MinIntervalSeconds, lastTick)
+}
+
+// setSensorTicks shows the ticks of the sensor under the cursor below the sensors list
+func setSensorTicks() {
+	sensor, ok := SensorsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		SensorTicksView.RenderContent([]string{})
+		return
+	}
+	SensorTicksView.Base.SetTitle(fmt.Sprintf("Last %d ticks of %s", sensorTicksLimit, sensor.Name))
+	lines := make([]string, 0)
+	for _, tick := range sensor.SensorState.Ticks {
+		lines = append(lines, formatTickResult(tick))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "no ticks")
+	}
+	SensorTicksView.RenderContent(lines)
+}
+
+func LoadSensors(g *c.Gui) {
+	repo := sensorsRepo
+	FetchAsync(g, SENSORS_VIEW, SensorsWindow.Base,
+		func(ctx context.Context) ([]s.Sensor, error) {
+			return Client.GetSensors(ctx, repo, sensorTicksLimit)
+		},
+		func(g *c.Gui, sensors []s.Sensor) error {
+			// the sensors window got closed while loading
+			if _, err := g.View(SENSORS_VIEW); err != nil {
+				return nil
+			}
+			selected, _ := SensorsWindow.GetRawElementOnCursorPosition()
+			SensorsWindow.RenderItems(sensors)
+			if !SensorsWindow.SelectElement(func(sensor s.Sensor) bool { return sensor.Name == selected.Name }) {
+				SensorsWindow.ResetCursor()
+			}
+			setSensorTicks()
+			return nil
+		})
+}
+
+func ReloadSensors(g *c.Gui, v *c.View) error {
+	LoadSensors(g)
+	return nil
+}
+
+func OpenSensorsWindow(g *c.Gui, v *c.View) error {
+	repo, err := selectedRepository(v)
+	if err != nil {
+		return OpenErrorWindow(g, err)
+	}
+	sensorsRepo = repo
+	maxX, maxY := g.Size()
+	splitY := int(float64(maxY) * 0.55)
+
+	SensorsWindow.Initialize(g, fmt.Sprintf("Sensors of %s", repo.Location), SENSORS_VIEW,
+		formatSensor, func(sensor s.Sensor) string { return sensor.Name })
+	SensorsWindow.Base.RenderView(g, int(float64(maxX)*0.1), int(float64(maxY)*0.1), int(float64(maxX)*0.9), splitY)
+	SensorsWindow.Base.SetNavigableFeedback(g)
+	SensorsWindow.Base.View.Wrap = false
+
+	// below SENSORS_VIEW
+	SensorTicksView.Initialize(g, "Ticks", SENSOR_TICKS_VIEW)
+	SensorTicksView.Base.RenderView(g, int(float64(maxX)*0.1), splitY+1, int(float64(maxX)*0.9), int(float64(maxY)*0.9))
+	SensorTicksView.Base.View.Wrap = true
+
+	LoadSensors(g)
+	return State.SetNewActiveWindow(g, v.Name(), SENSORS_VIEW)
+}
+
+func CloseSensorsWindow(g *c.Gui, v *c.View) error {
+	if err := g.DeleteView(SENSOR_TICKS_VIEW); err != nil {
+		return err
+	}
+	return ClosePopupView(g, v)
+}
+
+func CursorDownAndUpdateSensorTicks(g *c.Gui, v *c.View) error {
+	err := CursorDown(g, v)
+	setSensorTicks()
+	return err
+}
+
+func CursorUpAndUpdateSensorTicks(g *c.Gui, v *c.View) error {
+	err := CursorUp(g, v)
+	setSensorTicks()
+	return err
+}
+
+// ShowSensorCursor shows the cursor value the sensor stored with its last evaluation
+func ShowSensorCursor(g *c.Gui, v *c.View) error {
+	sensor, ok := SensorsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	cursor := sensor.SensorState.TypeSpecificData.LastCursor
+	if cursor == "" {
+		cursor = "<no cursor>"
+	}
+	return openFeedbackPopup(g, fmt.Sprintf("Cursor of %s", sensor.Name), cursor, SENSORS_VIEW)
+}
+
+// setSensorRunning starts or stops the sensor under the cursor after confirmation
+func setSensorRunning(g *c.Gui, v *c.View, running bool) error {
+	sensor, ok := SensorsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	if (sensor.SensorState.Status == "RUNNING") == running {
+		return nil
+	}
+	repo := sensorsRepo
+
+	action := "Stop"
+	if running {
+		action = "Start"
+	}
+	return AskConfirmation(g, v, fmt.Sprintf("%s sensor %s?", action, sensor.Name), func(g *c.Gui) error {
+		MutateAsync(g, "sensor_state", SensorsWindow.Base,
+			func(ctx context.Context) (struct{}, error) {
+				if running {
+					return struct{}{}, Client.StartSensor(ctx, repo, sensor.Name)
+				}
+				return struct{}{}, Client.StopSensor(ctx, sensor)
+			},
+			func(g *c.Gui, _ struct{}) error {
+				LoadSensors(g)
+				return nil
+			})
+		return nil
+	})
+}
+
+func StartSensor(g *c.Gui, v *c.View) error {
+	return setSensorRunning(g, v, true)
+}
+
+func StopSensor(g *c.Gui, v *c.View) error {
+	return setSensorRunning(g, v, false)
+}
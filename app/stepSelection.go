@@ -0,0 +1,92 @@
+package app
+
+import (
+	"fmt"
+	s "nl/vdb/dagstertui/internal"
+	"regexp"
+	"strings"
+)
+
+// a clause of an op selection query, like "*my_op", "++my_op+" or "my_op*"
+var selectionClauseRegex = regexp.MustCompile(`^(\*|\+*)([^*+]+)(\*|\+*)$`)
+
+// selectionClauses splits an op selection query into its clauses, separated by commas or spaces
+func selectionClauses(query string) []string {
+	return strings.FieldsFunc(query, func(r rune) bool { return r == ',' || r == ' ' })
+}
+
+// selectionDepth translates the operator of a clause to the number of levels to traverse, a * traverses
+// all levels and no path between steps is longer than the number of steps
+func selectionDepth(operator string, steps []s.ExecutionStep) int {
+	if operator == "*" {
+		return len(steps)
+	}
+	return len(operator)
+}
+
+// traverseSteps selects the step and the steps up to depth levels away along the edges, visited holds the
+// remaining depth a step got visited with so shared dependencies are only traversed again when it reaches further
+func traverseSteps(key string, edges map[string][]string, depth int, visited map[string]int, selected map[string]bool) {
+	if remaining, ok := visited[key]; ok && remaining >= depth {
+		return
+	}
+	visited[key] = depth
+	selected[key] = true
+	if depth == 0 {
+		return
+	}
+	for _, next := range edges[key] {
+		traverseSteps(next, edges, depth-1, visited, selected)
+	}
+}
+
+// resolveStepSelection resolves an op selection query to the step keys of the execution plan, a leading *
+// selects all ancestors and every leading + one level of ancestors, trailing operators select descendants.
+// Clauses naming no step of the plan are reported all at once
+func resolveStepSelection(query string, steps []s.ExecutionStep) ([]string, error) {
+	upstream := make(map[string][]string)
+	downstream := make(map[string][]string)
+	for _, step := range steps {
+		for _, input := range step.Inputs {
+			for _, dependency := range input.DependsOn {
+				upstream[step.Key] = append(upstream[step.Key], dependency.Key)
+				downstream[dependency.Key] = append(downstream[dependency.Key], step.Key)
+			}
+		}
+	}
+
+	known := make(map[string]bool)
+	for _, step := range steps {
+		known[step.Key] = true
+	}
+
+	selected := make(map[string]bool)
+	unknown := make([]string, 0)
+	for _, clause := range selectionClauses(query) {
+		match := selectionClauseRegex.FindStringSubmatch(clause)
+		if match == nil {
+			return nil, fmt.Errorf("Invalid op selection: %s", clause)
+		}
+		if !known[match[2]] {
+			unknown = append(unknown, clause)
+			continue
+		}
+		traverseSteps(match[2], upstream, selectionDepth(match[1], steps), make(map[string]int), selected)
+		traverseSteps(match[2], downstream, selectionDepth(match[3], steps), make(map[string]int), selected)
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("No step in the execution plan matches %s", strings.Join(unknown, ", "))
+	}
+
+	stepKeys := make([]string, 0)
+	for _, step := range steps {
+		if selected[step.Key] {
+			stepKeys = append(stepKeys, step.Key)
+		}
+	}
+	if len(stepKeys) == 0 {
+		return nil, fmt.Errorf("Op selection %s does not select any step", query)
+	}
+	return stepKeys, nil
+}
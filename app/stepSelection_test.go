@@ -0,0 +1,63 @@
+package app
+
+import (
+	"encoding/json"
+	s "nl/vdb/dagstertui/internal"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolveStepSelection(t *testing.T) {
+	// a -> b -> c -> d and a -> e
+	var steps []s.ExecutionStep
+	plan := `[
+		{"key": "a", "inputs": []},
+		{"key": "b", "inputs": [{"dependsOn": [{"key": "a"}]}]},
+		{"key": "c", "inputs": [{"dependsOn": [{"key": "b"}]}]},
+		{"key": "d", "inputs": [{"dependsOn": [{"key": "c"}]}]},
+		{"key": "e", "inputs": [{"dependsOn": [{"key": "a"}]}]}
+	]`
+	if err := json.Unmarshal([]byte(plan), &steps); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+		// part of the error message, empty when no error is expected
+		wantErr string
+	}{
+		{"plain step", "c", []string{"c"}, ""},
+		{"all ancestors", "*c", []string{"a", "b", "c"}, ""},
+		{"one level of ancestors", "+c", []string{"b", "c"}, ""},
+		{"two levels of descendants", "b++", []string{"b", "c", "d"}, ""},
+		{"all ancestors and one level of descendants", "*c+", []string{"a", "b", "c", "d"}, ""},
+		{"all descendants", "a*", []string{"a", "b", "c", "d", "e"}, ""},
+		{"clauses separated by commas", "e,b", []string{"b", "e"}, ""},
+		{"clauses separated by spaces", "e b", []string{"b", "e"}, ""},
+		{"overlapping clauses", "*b c+", []string{"a", "b", "c", "d"}, ""},
+		{"unknown step", "missing", nil, "missing"},
+		{"all unknown clauses are reported", "b,missing,+other", nil, "missing, +other"},
+		{"depth after the operator", "op+2", nil, "Invalid op selection: op+2"},
+		{"empty query", "", nil, "does not select any step"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := resolveStepSelection(test.query, steps)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("resolveStepSelection(%q) error = %v, want it to contain %q", test.query, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveStepSelection(%q) error = %v", test.query, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("resolveStepSelection(%q) = %v, want %v", test.query, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"strings"
+	"time"
+)
+
+// stepsRun is the run the steps view got opened for
+var stepsRun s.RunRepresentation
+
+// keys of the steps selected for re-execution
+var selectedSteps = make(map[string]bool)
+
+// time span of the run and column widths of STEPS_VIEW, set before rendering so the bars of all steps share a scale
+var stepsChart struct {
+	start, end float64
+	keyWidth   int
+	barWidth   int
+}
+
+func formatStepStatus(status string) string {
+	padded := fmt.Sprintf("%-11s", status)
+	switch status {
+	case "SUCCESS":
+		return fmt.Sprintf("\x1b[32m%s\x1b[0m", padded)
+	case "FAILURE":
+		return fmt.Sprintf("\x1b[31m%s\x1b[0m", padded)
+	case "IN_PROGRESS":
+		return fmt.Sprintf("\x1b[33m%s\x1b[0m", padded)
+	default:
+		return padded
+	}
+}
+
+// stepEndTime returns the end time of the step, steps that are still running end now
+func stepEndTime(step s.StepStats) float64 {
+	if step.EndTime == 0 && step.StartTime != 0 {
+		return float64(time.Now().Unix())
+	}
+	return step.EndTime
+}
+
+func formatStepDuration(step s.StepStats) string {
+	if step.StartTime == 0 {
+		return "-"
+	}
+	duration := time.Duration((stepEndTime(step) - step.StartTime) * float64(time.Second))
+	return duration.Round(time.Second).String()
+}
+
+// stepBar draws the time the step ran as a bar relative to the whole run
+func stepBar(step s.StepStats) string {
+	span := stepsChart.end - stepsChart.start
+	if step.StartTime == 0 || span <= 0 || stepsChart.barWidth <= 0 {
+		return ""
+	}
+	offset := int((step.StartTime - stepsChart.start) / span * float64(stepsChart.barWidth))
+	length := int((stepEndTime(step) - step.StartTime) / span * float64(stepsChart.barWidth))
+	if length < 1 {
+		length = 1
+	}
+	if offset+length > stepsChart.barWidth {
+		offset = stepsChart.barWidth - length
+	}
+	return fmt.Sprintf("%s%s", strings.Repeat(" ", offset), strings.Repeat("█", length))
+}
+
+func formatStep(step s.StepStats) string {
+	attempts := ""
+	if len(step.Attempts) > 1 {
+		attempts = fmt.Sprintf(" (%d attempts)", len(step.Attempts))
+	}
+	marker := "[ ]"
+	if selectedSteps[step.StepKey] {
+		marker = "[x]"
+	}
+	return fmt.Sprintf("%s %s %-*s %9s  │%s", marker, formatStepStatus(step.Status), stepsChart.keyWidth, step.StepKey+attempts, formatStepDuration(step), stepBar(step))
+}
+
+// sortStepsOn sorts the steps by start time, steps that did not start yet are listed last
+func sortStepsOn(step s.StepStats) string {
+	if step.StartTime == 0 {
+		return fmt.Sprintf("~%s", step.StepKey)
+	}
+	return fmt.Sprintf("%020.3f", step.StartTime)
+}
+
+func renderSteps(steps []s.StepStats) {
+	stepsChart.start, stepsChart.end, stepsChart.keyWidth = 0, 0, 0
+	for _, step := range steps {
+		if step.StartTime != 0 && (stepsChart.start == 0 || step.StartTime < stepsChart.start) {
+			stepsChart.start = step.StartTime
+		}
+		if stepEndTime(step) > stepsChart.end {
+			stepsChart.end = stepEndTime(step)
+		}
+		if len(step.StepKey)+14 > stepsChart.keyWidth {
+			stepsChart.keyWidth = len(step.StepKey) + 14
+		}
+	}
+	width, _ := StepsWindow.Base.View.Size()
+	// status, key, duration and separators take up the rest of the line
+	stepsChart.barWidth = width - stepsChart.keyWidth - 30
+	StepsWindow.RenderItems(steps)
+}
+
+func LoadSteps(g *c.Gui) {
+	runId := stepsRun.RunId
+	FetchAsync(g, STEPS_VIEW, StepsWindow.Base,
+		func(ctx context.Context) ([]s.StepStats, error) {
+			return Client.GetStepStats(ctx, runId)
+		},
+		func(g *c.Gui, steps []s.StepStats) error {
+			// the steps window got closed while loading
+			if _, err := g.View(STEPS_VIEW); err != nil {
+				return nil
+			}
+			selected, _ := StepsWindow.GetRawElementOnCursorPosition()
+			renderSteps(steps)
+			if !StepsWindow.SelectElement(func(step s.StepStats) bool { return step.StepKey == selected.StepKey }) {
+				StepsWindow.ResetCursor()
+			}
+			return nil
+		})
+}
+
+func ReloadSteps(g *c.Gui, v *c.View) error {
+	LoadSteps(g)
+	return nil
+}
+
+// OpenStepsWindow shows the steps of the selected run with their status and duration
+func OpenStepsWindow(g *c.Gui, v *c.View) error {
+	SelectedRun := RunsWindow.GetElementOnCursorPosition()
+	if SelectedRun == "" {
+		return nil
+	}
+	run, ok := Overview.FindRunIdBySubstring(State.SelectedRepo, State.SelectedJob, SelectedRun)
+	if !ok {
+		return nil
+	}
+	stepsRun = run
+	maxX, maxY := g.Size()
+
+	StepsWindow.Initialize(g, fmt.Sprintf("Steps of %s", stepsRun.RunId), STEPS_VIEW, formatStep, sortStepsOn)
+	StepsWindow.Base.RenderView(g, int(float64(maxX)*0.05), int(float64(maxY)*0.1), int(float64(maxX)*0.95), int(float64(maxY)*0.9))
+	StepsWindow.Base.SetNavigableFeedback(g)
+	StepsWindow.Base.View.Wrap = false
+	selectedSteps = make(map[string]bool)
+	LoadSteps(g)
+
+	return State.SetNewActiveWindow(g, v.Name(), STEPS_VIEW)
+}
+
+func setStepsTitle() {
+	title := fmt.Sprintf("Steps of %s", stepsRun.RunId)
+	if len(selectedSteps) > 0 {
+		title = fmt.Sprintf("%s - %d selected", title, len(selectedSteps))
+	}
+	StepsWindow.Base.SetTitle(title)
+}
+
+func ToggleStepSelection(g *c.Gui, v *c.View) error {
+	step, ok := StepsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	if selectedSteps[step.StepKey] {
+		delete(selectedSteps, step.StepKey)
+	} else {
+		selectedSteps[step.StepKey] = true
+	}
+	StepsWindow.RefreshItems()
+	setStepsTitle()
+	return nil
+}
+
+// reexecuteSteps asks for confirmation and launches a re-execution of the run the steps view got opened for,
+// limited to the step keys
+func reexecuteSteps(g *c.Gui, stepKeys []string, stepSelection string) error {
+	run := stepsRun
+	target := selectedJobTarget()
+
+	message := fmt.Sprintf("Re-execute %d step(s)?", len(stepKeys))
+	return AskConfirmation(g, StepsWindow.Base.View, message, func(g *c.Gui) error {
+		MutateAsync(g, "reexecute_steps", StepsWindow.Base,
+			func(ctx context.Context) (launchResult, error) {
+				runId, err := Client.ReexecuteSteps(ctx, target.repo, target.jobName, run, stepKeys, stepSelection)
+				return asLaunchResult(target, runId, err)
+			},
+			func(g *c.Gui, result launchResult) error {
+				if _, err := g.View(STEPS_VIEW); err == nil && result.invalid == nil {
+					if err := ClosePopupView(g, StepsWindow.Base.View); err != nil {
+						return err
+					}
+				}
+				return applyLaunchResult(g, result)
+			})
+		return nil
+	})
+}
+
+// ReexecuteSelectedSteps re-executes the selected steps, or the step under the cursor when nothing is selected
+func ReexecuteSelectedSteps(g *c.Gui, v *c.View) error {
+	if isRunInProgress(stepsRun.Status) {
+		return OpenErrorWindow(g, fmt.Errorf("Run %s has not finished yet", stepsRun.RunId))
+	}
+	stepKeys := make([]string, 0)
+	for _, step := range StepsWindow.RawElements {
+		if selectedSteps[step.StepKey] {
+			stepKeys = append(stepKeys, step.StepKey)
+		}
+	}
+	if len(stepKeys) == 0 {
+		step, ok := StepsWindow.GetRawElementOnCursorPosition()
+		if !ok {
+			return nil
+		}
+		stepKeys = append(stepKeys, step.StepKey)
+	}
+	return reexecuteSteps(g, stepKeys, strings.Join(stepKeys, ","))
+}
+
+// OpenStepSelectionQuery opens an input to type an op selection query like *my_op+ to re-execute
+func OpenStepSelectionQuery(g *c.Gui, v *c.View) error {
+	if isRunInProgress(stepsRun.Status) {
+		return OpenErrorWindow(g, fmt.Errorf("Run %s has not finished yet", stepsRun.RunId))
+	}
+	maxX, maxY := g.Size()
+
+	StepQueryView.Initialize(g, "Op selection, e.g. *my_op+ - Enter to re-execute", STEP_QUERY_VIEW)
+	StepQueryView.Base.RenderView(g, int(float64(maxX)*0.25), maxY/2-1, int(float64(maxX)*0.75), maxY/2+1)
+	StepQueryView.Base.View.Editable = true
+	StepQueryView.Base.View.Editor = DefaultEditor
+	StepQueryView.RenderContent([]string{})
+
+	return State.SetNewActiveWindow(g, v.Name(), STEP_QUERY_VIEW)
+}
+
+// ReexecuteStepSelectionQuery resolves the typed op selection to step keys of the execution plan and re-executes them
+func ReexecuteStepSelectionQuery(g *c.Gui, v *c.View) error {
+	query := strings.TrimSpace(strings.Join(v.BufferLines(), " "))
+	if err := ClosePopupView(g, v); err != nil {
+		return err
+	}
+	if query == "" {
+		return nil
+	}
+	run := stepsRun
+	target := selectedJobTarget()
+	FetchAsync(g, "step_selection_query", StepsWindow.Base,
+		func(ctx context.Context) ([]string, error) {
+			steps, err := Client.GetExecutionPlan(ctx, target.repo, target.jobName, run.RunconfigYaml)
+			if err != nil {
+				return nil, err
+			}
+			return resolveStepSelection(query, steps)
+		},
+		func(g *c.Gui, stepKeys []string) error {
+			// the steps window got closed while resolving the selection
+			if _, err := g.View(STEPS_VIEW); err != nil {
+				return nil
+			}
+			return reexecuteSteps(g, stepKeys, query)
+		})
+	return nil
+}
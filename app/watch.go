@@ -0,0 +1,193 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	c "github.com/jroimartin/gocui"
+	s "nl/vdb/dagstertui/internal"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// how often the status of the watched runs gets checked
+const watchPollInterval = 5 * time.Second
+
+// how long a check of the watched runs may take, a check that hangs would hold up all later checks
+const watchCheckTimeout = 30 * time.Second
+
+// watchState holds the ids of the watched runs, like logsFollowState it is only touched from the gocui main loop
+type watchState struct {
+	runs     map[string]bool
+	checking bool
+	stop     chan struct{}
+}
+
+var watch = &watchState{runs: make(map[string]bool)}
+
+func watchedMarker(runId string) string {
+	if watch.runs[runId] {
+		return " \t (watched)"
+	}
+	return ""
+}
+
+// showStatus replaces the message in the status bar
+func showStatus(message string) {
+	StatusView.RenderContent([]string{fmt.Sprintf("%s %s", time.Now().Format("15:04:05"), message)})
+}
+
+// ToggleWatchRun starts or stops watching the run under the cursor in RUNS_VIEW
+func ToggleWatchRun(g *c.Gui, v *c.View) error {
+	run, ok := RunsWindow.GetRawElementOnCursorPosition()
+	if !ok {
+		return nil
+	}
+	switch {
+	case watch.runs[run.RunId]:
+		delete(watch.runs, run.RunId)
+		showStatus(fmt.Sprintf("Stopped watching run %s", run.RunId))
+	case isRunFinished(run.Status):
+		showStatus(fmt.Sprintf("Run %s has already finished", run.RunId))
+		return nil
+	default:
+		watch.runs[run.RunId] = true
+		showStatus(fmt.Sprintf("Watching run %s of %s", run.RunId, State.SelectedJob))
+		startWatching(g)
+	}
+	RunsWindow.RefreshItems()
+	return nil
+}
+
+func startWatching(g *c.Gui) {
+	if watch.stop != nil {
+		return
+	}
+	stop := make(chan struct{})
+	watch.stop = stop
+	go pollWatchedRuns(g, stop)
+}
+
+func stopWatching() {
+	if watch.stop == nil {
+		return
+	}
+	close(watch.stop)
+	watch.stop = nil
+}
+
+// pollWatchedRuns checks the watched runs every watchPollInterval until stop is closed, which happens once
+// there are no watched runs left
+func pollWatchedRuns(g *c.Gui, stop chan struct{}) {
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		g.Update(func(g *c.Gui) error {
+			if watch.stop != stop || watch.checking {
+				return nil
+			}
+			runIds := make([]string, 0)
+			for runId := range watch.runs {
+				runIds = append(runIds, runId)
+			}
+			if len(runIds) == 0 {
+				stopWatching()
+				return nil
+			}
+			watch.checking = true
+			go checkWatchedRuns(g, runIds)
+			return nil
+		})
+	}
+}
+
+// checkWatchedRuns fetches the status of the runs and notifies about the ones that have finished, runs dagster
+// no longer knows are not watched anymore. Errors end up in the status bar since a popup every poll would get in the way
+func checkWatchedRuns(g *c.Gui, runIds []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), watchCheckTimeout)
+	defer cancel()
+	runs, err := Client.SearchRuns(ctx, map[string]any{"runIds": runIds}, "", len(runIds))
+
+	g.Update(func(g *c.Gui) error {
+		watch.checking = false
+		if err != nil {
+			showStatus(fmt.Sprintf("Checking the watched runs failed: %s", err))
+			return nil
+		}
+		found := make(map[string]bool)
+		for _, run := range runs {
+			found[run.RunId] = true
+		}
+		for _, runId := range runIds {
+			if watch.runs[runId] && !found[runId] {
+				delete(watch.runs, runId)
+				showStatus(fmt.Sprintf("Run %s no longer exists, stopped watching it", runId))
+			}
+		}
+		for _, run := range runs {
+			// unwatched in the meantime
+			if !watch.runs[run.RunId] || !isRunFinished(run.Status) {
+				continue
+			}
+			delete(watch.runs, run.RunId)
+			notifyRunFinished(g, run)
+
+			if run.JobName == State.SelectedJob && run.RepositoryOrigin.RepositoryLocationName == State.SelectedRepo && !refreshPaused(g) {
+				refreshRuns(g)
+			}
+		}
+		RunsWindow.RefreshItems()
+		return nil
+	})
+}
+
+// notifyRunFinished rings the terminal bell, shows the run in the status bar and runs the notify command of the config
+func notifyRunFinished(g *c.Gui, run s.Run) {
+	showStatus(fmt.Sprintf("Run %s of %s finished: %s", run.RunId, run.JobName, formatRunStatus(run.Status)))
+	ringBell(g)
+
+	if Conf.NotifyCommand == "" {
+		return
+	}
+	go func() {
+		if err := runNotifyCommand(Conf.NotifyCommand, run); err != nil {
+			g.Update(func(g *c.Gui) error {
+				showStatus(fmt.Sprintf("Notify command failed: %s", err))
+				return nil
+			})
+		}
+	}()
+}
+
+// ringBell rings the terminal bell from an update func. termbox only writes to the terminal when gocui flushes
+// at the end of an iteration of the main loop, so a bell written from within the loop lands before that flush
+// instead of in the middle of its escape sequences
+func ringBell(g *c.Gui) {
+	g.Update(func(g *c.Gui) error {
+		fmt.Fprint(os.Stdout, "\a")
+		return nil
+	})
+}
+
+func runNotifyCommand(command string, run s.Run) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("DAGSTER_RUN_ID=%s", run.RunId),
+		fmt.Sprintf("DAGSTER_RUN_JOB=%s", run.JobName),
+		fmt.Sprintf("DAGSTER_RUN_STATUS=%s", run.Status),
+	)
+	return cmd.Run()
+}
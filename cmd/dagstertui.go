@@ -7,6 +7,7 @@ import (
 	s "nl/vdb/dagstertui/internal"
 	"os"
 	"strings"
+	"time"
 
 	c "github.com/jroimartin/gocui"
 )
@@ -22,6 +23,7 @@ func main() {
 	LoadConfig(home)
 
 	environmentFlag := flag.String("e", "default", "sets the home url of the dagster environment")
+	refreshFlag := flag.Int("refresh", Conf.RefreshInterval, "seconds between refreshes of the repositories and runs, 0 disables refreshing")
 
 	// Parse the command-line arguments to set the value of environmentFlag
 	flag.Parse()
@@ -47,7 +49,7 @@ func main() {
 	}
 
 	// Initialize gocui
-	g, err := c.NewGui(c.Output256)
+	g, err := c.NewGui(GuiOutputMode)
 	g.InputEsc = true
 
 	if err != nil {
@@ -74,9 +76,8 @@ func main() {
 
 	SetWindowColors(g, REPOSITORIES_VIEW, "red")
 
-	repos := Client.LoadRepositories()
-	Overview.AppendRepositories(repos)
-	RepoWindow.RenderItems(Overview.GetRepositoryList())
+	LoadRepositories(g)
+	StartAutoRefresh(g, time.Duration(*refreshFlag)*time.Second)
 
 	EnvironmentInfoView.RenderContent([]string{strings.TrimPrefix(Overview.Url, "https://")})
 
@@ -1,5 +1,23 @@
 package internal
 
+import (
+	"encoding/json"
+	"strings"
+)
+
+type GraphQLRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	OperationName string         `json:"operationName,omitempty"`
+}
+
+type GraphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
 type Repository struct {
 	Name     string `json:"name"`
 	Location struct {
@@ -8,30 +26,52 @@ type Repository struct {
 }
 
 type RepositoriesResponse struct {
-	Data struct {
-		RepositoriesOrError struct {
-			Nodes []Repository `json:"nodes"`
-		} `json:"repositoriesOrError"`
-	} `json:"data"`
+	RepositoriesOrError struct {
+		TypeName string       `json:"__typename"`
+		Message  string       `json:"message"`
+		Nodes    []Repository `json:"nodes"`
+	} `json:"repositoriesOrError"`
+}
+
+type EvaluationStackEntry struct {
+	TypeName  string `json:"__typename"`
+	FieldName string `json:"fieldName"`
+	ListIndex int    `json:"listIndex"`
+	MapKey    any    `json:"mapKey"`
+}
+
+type ConfigValidationError struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+	Stack   struct {
+		Entries []EvaluationStackEntry `json:"entries"`
+	} `json:"stack"`
 }
 
 type LaunchRunResponse struct {
-	Data struct {
-		LaunchRun struct {
-			Run struct {
-				RunId string `json:"runId"`
-			} `json:"run"`
-		} `json:"launchRun"`
-	} `json:"data"`
+	LaunchRun struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+		Run      struct {
+			RunId string `json:"runId"`
+		} `json:"run"`
+		Errors []ConfigValidationError `json:"errors"`
+	} `json:"launchRun"`
+}
+
+type ValidateRunConfigResponse struct {
+	IsPipelineConfigValid struct {
+		TypeName string                  `json:"__typename"`
+		Message  string                  `json:"message"`
+		Errors   []ConfigValidationError `json:"errors"`
+	} `json:"isPipelineConfigValid"`
 }
 
 type TerminateRunResponse struct {
-	Data struct {
-		TerminateRun struct {
-			TypeName string `json:"__typename"`
-			Message  string `json:"message"`
-		} `json:"terminateRun"`
-	} `json:"data"`
+	TerminateRun struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+	} `json:"terminateRun"`
 }
 
 type Jobs struct {
@@ -45,11 +85,11 @@ type Job struct {
 }
 
 type JobsResponse struct {
-	Data struct {
-		RepositoriesOrError struct {
-			Jobs []Job `json:"jobs"`
-		} `json:"repositoryOrError"`
-	} `json:"data"`
+	RepositoriesOrError struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+		Jobs     []Job  `json:"jobs"`
+	} `json:"repositoryOrError"`
 }
 
 type Preset struct {
@@ -57,16 +97,21 @@ type Preset struct {
 }
 
 type PipelineOrError struct {
-	Id      string   `json:"id"`
-	Name    string   `json:"name"`
-	Presets []Preset `json:"presets"`
-	Runs    []Run    `json:"runs"`
+	TypeName string   `json:"__typename"`
+	Message  string   `json:"message"`
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	Presets  []Preset `json:"presets"`
+	Runs     []Run    `json:"runs"`
 }
 
 type RunsResponse struct {
-	Data struct {
-		PipelineOrError PipelineOrError `json:"pipelineOrError"`
-	} `json:"data"`
+	PipelineOrError PipelineOrError `json:"pipelineOrError"`
+}
+
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
 type Run struct {
@@ -75,4 +120,357 @@ type Run struct {
 	EndTime       float64 `json:"endTime"`
 	Status        string  `json:"status"`
 	RunConfigYaml string  `json:"runConfigYaml"`
+	Tags          []Tag   `json:"tags"`
+	// only requested when searching runs across jobs
+	JobName          string `json:"jobName"`
+	RepositoryOrigin struct {
+		RepositoryLocationName string `json:"repositoryLocationName"`
+		RepositoryName         string `json:"repositoryName"`
+	} `json:"repositoryOrigin"`
+}
+
+type RunEvent struct {
+	TypeName  string `json:"__typename"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+	Level     string `json:"level"`
+	StepKey   string `json:"stepKey"`
+	Error     *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+	// set for LogsCapturedEvent, the file key identifies the compute logs of the steps
+	FileKey  string   `json:"fileKey"`
+	StepKeys []string `json:"stepKeys"`
+}
+
+type LogsForRunResponse struct {
+	LogsForRun struct {
+		TypeName string     `json:"__typename"`
+		Message  string     `json:"message"`
+		Events   []RunEvent `json:"events"`
+		Cursor   string     `json:"cursor"`
+		HasMore  bool       `json:"hasMore"`
+	} `json:"logsForRun"`
+}
+
+type PartitionSet struct {
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+}
+
+type PartitionSetsResponse struct {
+	PartitionSetsOrError struct {
+		TypeName string         `json:"__typename"`
+		Message  string         `json:"message"`
+		Results  []PartitionSet `json:"results"`
+	} `json:"partitionSetsOrError"`
+}
+
+type PartitionNamesResponse struct {
+	PartitionSetOrError struct {
+		TypeName          string `json:"__typename"`
+		Message           string `json:"message"`
+		PartitionsOrError struct {
+			TypeName string `json:"__typename"`
+			Message  string `json:"message"`
+			Results  []struct {
+				Name string `json:"name"`
+			} `json:"results"`
+		} `json:"partitionsOrError"`
+	} `json:"partitionSetOrError"`
+}
+
+type PartitionResponse struct {
+	PartitionSetOrError struct {
+		TypeName  string `json:"__typename"`
+		Message   string `json:"message"`
+		Partition *struct {
+			RunConfigOrError struct {
+				TypeName string `json:"__typename"`
+				Message  string `json:"message"`
+				Yaml     string `json:"yaml"`
+			} `json:"runConfigOrError"`
+			TagsOrError struct {
+				TypeName string `json:"__typename"`
+				Message  string `json:"message"`
+				Results  []Tag  `json:"results"`
+			} `json:"tagsOrError"`
+		} `json:"partition"`
+	} `json:"partitionSetOrError"`
+}
+
+type LaunchBackfillResponse struct {
+	LaunchPartitionBackfill struct {
+		TypeName   string                  `json:"__typename"`
+		Message    string                  `json:"message"`
+		BackfillId string                  `json:"backfillId"`
+		Errors     []ConfigValidationError `json:"errors"`
+	} `json:"launchPartitionBackfill"`
+}
+
+type PartitionStatusCount struct {
+	RunStatus string `json:"runStatus"`
+	Count     int    `json:"count"`
+}
+
+type Backfill struct {
+	Id                    string                 `json:"id"`
+	Status                string                 `json:"status"`
+	NumPartitions         int                    `json:"numPartitions"`
+	NumCancelable         int                    `json:"numCancelable"`
+	PartitionSetName      string                 `json:"partitionSetName"`
+	Timestamp             float64                `json:"timestamp"`
+	PartitionStatusCounts []PartitionStatusCount `json:"partitionStatusCounts"`
+}
+
+type BackfillsResponse struct {
+	PartitionBackfillsOrError struct {
+		TypeName string     `json:"__typename"`
+		Message  string     `json:"message"`
+		Results  []Backfill `json:"results"`
+	} `json:"partitionBackfillsOrError"`
+}
+
+type CancelBackfillResponse struct {
+	CancelPartitionBackfill struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+	} `json:"cancelPartitionBackfill"`
+}
+
+type ResumeBackfillResponse struct {
+	ResumePartitionBackfill struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+	} `json:"resumePartitionBackfill"`
+}
+
+type InstigationTick struct {
+	Status     string   `json:"status"`
+	Timestamp  float64  `json:"timestamp"`
+	SkipReason string   `json:"skipReason"`
+	RunIds     []string `json:"runIds"`
+	Cursor     string   `json:"cursor"`
+	Error      *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type InstigationState struct {
+	Id         string            `json:"id"`
+	SelectorId string            `json:"selectorId"`
+	Status     string            `json:"status"`
+	Ticks      []InstigationTick `json:"ticks"`
+}
+
+type Schedule struct {
+	Name              string           `json:"name"`
+	CronSchedule      string           `json:"cronSchedule"`
+	ExecutionTimezone string           `json:"executionTimezone"`
+	PipelineName      string           `json:"pipelineName"`
+	ScheduleState     InstigationState `json:"scheduleState"`
+	FutureTicks       struct {
+		Results []struct {
+			Timestamp float64 `json:"timestamp"`
+		} `json:"results"`
+	} `json:"futureTicks"`
+}
+
+type SchedulesResponse struct {
+	SchedulesOrError struct {
+		TypeName string     `json:"__typename"`
+		Message  string     `json:"message"`
+		Results  []Schedule `json:"results"`
+	} `json:"schedulesOrError"`
+}
+
+type ScheduleMutationResult struct {
+	TypeName      string `json:"__typename"`
+	Message       string `json:"message"`
+	ScheduleState struct {
+		Status string `json:"status"`
+	} `json:"scheduleState"`
+}
+
+type StartScheduleResponse struct {
+	StartSchedule ScheduleMutationResult `json:"startSchedule"`
+}
+
+type StopScheduleResponse struct {
+	StopRunningSchedule ScheduleMutationResult `json:"stopRunningSchedule"`
+}
+
+type Sensor struct {
+	Name               string `json:"name"`
+	SensorType         string `json:"sensorType"`
+	MinIntervalSeconds int    `json:"minIntervalSeconds"`
+	SensorState        struct {
+		InstigationState
+		TypeSpecificData struct {
+			LastCursor string `json:"lastCursor"`
+		} `json:"typeSpecificData"`
+	} `json:"sensorState"`
+}
+
+type SensorsResponse struct {
+	SensorsOrError struct {
+		TypeName string   `json:"__typename"`
+		Message  string   `json:"message"`
+		Results  []Sensor `json:"results"`
+	} `json:"sensorsOrError"`
+}
+
+type StartSensorResponse struct {
+	StartSensor struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+	} `json:"startSensor"`
+}
+
+type StopSensorResponse struct {
+	StopSensor struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+	} `json:"stopSensor"`
+}
+
+type AssetKey struct {
+	Path []string `json:"path"`
+}
+
+func (k AssetKey) String() string {
+	return strings.Join(k.Path, "/")
+}
+
+type AssetMaterialization struct {
+	RunId     string `json:"runId"`
+	Timestamp string `json:"timestamp"`
+	Partition string `json:"partition"`
+	StepKey   string `json:"stepKey"`
+}
+
+type AssetNode struct {
+	Id         string   `json:"id"`
+	AssetKey   AssetKey `json:"assetKey"`
+	GroupName  string   `json:"groupName"`
+	JobNames   []string `json:"jobNames"`
+	Repository struct {
+		Name     string `json:"name"`
+		Location struct {
+			Name string `json:"name"`
+		} `json:"location"`
+	} `json:"repository"`
+	StaleStatus           string                 `json:"staleStatus"`
+	AssetMaterializations []AssetMaterialization `json:"assetMaterializations"`
+	DependencyKeys        []AssetKey             `json:"dependencyKeys"`
+	DependedByKeys        []AssetKey             `json:"dependedByKeys"`
+}
+
+type AssetNodesResponse struct {
+	AssetNodes []AssetNode `json:"assetNodes"`
+}
+
+type AssetMaterializationsResponse struct {
+	AssetOrError struct {
+		TypeName              string                 `json:"__typename"`
+		Message               string                 `json:"message"`
+		AssetMaterializations []AssetMaterialization `json:"assetMaterializations"`
+	} `json:"assetOrError"`
+}
+
+type AssetLatestInfo struct {
+	AssetKey              AssetKey `json:"assetKey"`
+	LatestMaterialization *struct {
+		Timestamp string `json:"timestamp"`
+		RunId     string `json:"runId"`
+	} `json:"latestMaterialization"`
+	LatestRun *struct {
+		RunId  string `json:"runId"`
+		Status string `json:"status"`
+	} `json:"latestRun"`
+	InProgressRunIds []string `json:"inProgressRunIds"`
+	UnstartedRunIds  []string `json:"unstartedRunIds"`
+}
+
+type AssetsLatestInfoResponse struct {
+	AssetsLatestInfo []AssetLatestInfo `json:"assetsLatestInfo"`
+}
+
+type StepStats struct {
+	StepKey   string  `json:"stepKey"`
+	Status    string  `json:"status"`
+	StartTime float64 `json:"startTime"`
+	EndTime   float64 `json:"endTime"`
+	Attempts  []struct {
+		StartTime float64 `json:"startTime"`
+		EndTime   float64 `json:"endTime"`
+	} `json:"attempts"`
+}
+
+type RunStepStatsResponse struct {
+	RunOrError struct {
+		TypeName  string      `json:"__typename"`
+		Message   string      `json:"message"`
+		RunId     string      `json:"runId"`
+		Status    string      `json:"status"`
+		StepStats []StepStats `json:"stepStats"`
+	} `json:"runOrError"`
+}
+
+type LaunchReexecutionResponse struct {
+	LaunchPipelineReexecution struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+		Run      struct {
+			RunId string `json:"runId"`
+		} `json:"run"`
+		Errors []ConfigValidationError `json:"errors"`
+	} `json:"launchPipelineReexecution"`
+}
+
+type ExecutionStep struct {
+	Key    string `json:"key"`
+	Inputs []struct {
+		DependsOn []struct {
+			Key string `json:"key"`
+		} `json:"dependsOn"`
+	} `json:"inputs"`
+}
+
+type ExecutionPlanResponse struct {
+	ExecutionPlanOrError struct {
+		TypeName string                  `json:"__typename"`
+		Message  string                  `json:"message"`
+		Steps    []ExecutionStep         `json:"steps"`
+		Errors   []ConfigValidationError `json:"errors"`
+	} `json:"executionPlanOrError"`
+}
+
+type CapturedLogs struct {
+	Stdout string `json:"stdout"`
+	Stderr string `json:"stderr"`
+	Cursor string `json:"cursor"`
+}
+
+type CapturedLogsResponse struct {
+	CapturedLogs CapturedLogs `json:"capturedLogs"`
+}
+
+type ComputeLogFile struct {
+	Data string `json:"data"`
+}
+
+type ComputeLogsResponse struct {
+	ComputeLogs struct {
+		Stdout *ComputeLogFile `json:"stdout"`
+		Stderr *ComputeLogFile `json:"stderr"`
+	} `json:"computeLogs"`
+}
+
+type SearchRunsResponse struct {
+	RunsOrError struct {
+		TypeName string `json:"__typename"`
+		Message  string `json:"message"`
+		Results  []Run  `json:"results"`
+	} `json:"runsOrError"`
 }
@@ -11,6 +11,17 @@ type RunRepresentation struct {
 	EndTime       float64
 	Status        string
 	RunconfigYaml string
+	Tags          []Tag
+}
+
+type PartitionRepresentation struct {
+	SetName string
+	Name    string
+}
+
+type PartitionRunConfig struct {
+	RunConfigYaml string
+	Tags          []Tag
 }
 
 type JobRepresentation struct {
@@ -68,6 +79,24 @@ func (o *Overview) AppendRepositories(repos []Repository) {
 	}
 }
 
+// MergeRepositories adds the repositories that are new and drops the ones that are gone, the jobs and runs loaded
+// for the others are kept. The repository with location keep is never dropped.
+func (o *Overview) MergeRepositories(repos []Repository, keep string) {
+	found := make(map[string]bool)
+	for _, node := range repos {
+		found[node.Location.Name] = true
+		if _, ok := o.Repositories[node.Location.Name]; ok {
+			continue
+		}
+		o.AppendRepositories([]Repository{node})
+	}
+	for location := range o.Repositories {
+		if !found[location] && location != keep {
+			delete(o.Repositories, location)
+		}
+	}
+}
+
 func (o *Overview) AppendJobsToRepository(location string, Jobs []Job) {
 
 	for _, job := range Jobs {
@@ -83,18 +112,48 @@ func (o *Overview) AppendJobsToRepository(location string, Jobs []Job) {
 }
 
 func (o *Overview) UpdatePipelineAndRuns(location string, pipeline PipelineOrError) {
-	SelectedJob := o.Repositories[location].Jobs[pipeline.Name]
+	SelectedJob, ok := o.findJob(location, pipeline.Name)
+	if !ok {
+		return
+	}
 	if len(pipeline.Presets) > 0 {
 		SelectedJob.DefaultRunConfigYaml = pipeline.Presets[0].RunConfigYaml
 	}
 	SelectedJob.Runs = make([]*RunRepresentation, 0)
+	o.AppendRuns(location, pipeline)
+}
+
+// findJob returns the job of the repository, false when a reload of the repositories dropped either of them
+func (o *Overview) findJob(location string, pipelineName string) (*JobRepresentation, bool) {
+	repo, ok := o.Repositories[location]
+	if !ok {
+		return nil, false
+	}
+	job, ok := repo.Jobs[pipelineName]
+	return job, ok && job != nil
+}
+
+// AppendRuns adds the runs of a next page to the runs of the job, runs that are already known are skipped
+func (o *Overview) AppendRuns(location string, pipeline PipelineOrError) {
+	SelectedJob, ok := o.findJob(location, pipeline.Name)
+	if !ok {
+		return
+	}
+	known := make(map[string]bool)
+	for _, run := range SelectedJob.Runs {
+		known[run.RunId] = true
+	}
 	for _, run := range pipeline.Runs {
+		if known[run.RunId] {
+			continue
+		}
 		runRep := new(RunRepresentation)
 		runRep.RunId = run.RunId
 		runRep.StartTime = run.StartTime
 		runRep.EndTime = run.EndTime
 		runRep.RunconfigYaml = run.RunConfigYaml
 		runRep.Status = run.Status
+		runRep.Tags = run.Tags
 
 		SelectedJob.Runs = append(SelectedJob.Runs, runRep)
 	}
@@ -108,13 +167,19 @@ func (o *Overview) GetSortedRunNamesFor(location string, pipelineName string) []
 	return runNames
 }
 
-func (o *Overview) FindRunIdBySubstring(location string, pipelineName string, info string) RunRepresentation {
-	for _, run := range o.Repositories[location].Jobs[pipelineName].Runs {
+// FindRunIdBySubstring returns the loaded run of the job whose id is part of info, false when there is none,
+// e.g. because the runs got reloaded in the meantime
+func (o *Overview) FindRunIdBySubstring(location string, pipelineName string, info string) (RunRepresentation, bool) {
+	job, ok := o.findJob(location, pipelineName)
+	if !ok {
+		return RunRepresentation{}, false
+	}
+	for _, run := range job.Runs {
 		if strings.Contains(info, run.RunId) {
-			return *run
+			return *run, true
 		}
 	}
-	panic("Couldnt find any run")
+	return RunRepresentation{}, false
 }
 
 func (o *Overview) GetRunsFor(location string, pipelineName string) []RunRepresentation {
@@ -9,24 +9,132 @@ q           Closes the application
 x           Open KeyMap View
 ESC		    Close KeyMapView
 
+Repositories, Jobs and Runs - View
+--
+B           Open Backfills View
+A           Open Assets View
+F           Search runs across all jobs and code locations
+
 Repositories - View
 --
 Enter       Load Jobs for selected Repository
 f           Filter the repositories list
+s           Open the Schedules of the selected Repository
+n           Open the Sensors of the selected Repository
 
 Job - View
 --
 Enter       Load Runs for selected Job
 f           Filter the job list - TBD
 L           Open Launch Window with the default config for this job
+p           Pick a partition of the job to launch a run for
+s           Open the Schedules of the Repository
+n           Open the Sensors of the Repository
 
 Runs - View
 --
-L           Open Launch Window with the config from the selected run
+∧ v         Arrow Keys, Scroll through the runs, scrolling past the last run loads the next page
+L           Open Launch Window with the config and tags from the selected run
 ESC 		Closes Launch Window
 t			Terminates selected run with confirmation window
 T			Terminates selected run immediatly
+g			Open the logs of the selected run
+p			Pick a partition of the job to launch a run for
+o			Open the steps of the selected run with their status and duration
+r			Re-executes the selected failed run from failure with confirmation window
+R			Re-executes all steps of the selected run with confirmation window
+w			Watch or unwatch the selected run, a finished watched run rings the bell and shows up in the status bar
+d			Mark or unmark the selected run for a diff, marking a second run opens the diff of their config and tags
+
+Logs - View
+--
+∧ v         Arrow Keys, Scroll through the log lines
+PgUp PgDn   Scroll a page up or down
+f           Toggle following new events of the run, enabled by default for started and queued runs
+ESC         Closes the Logs View
+
+Steps - View
+--
+∧ v         Arrow Keys, Scroll through the steps, sorted by start time, the bar shows when the step ran
+Space       Select or unselect the step for re-execution
+r           Re-executes the selected steps, or the one under the cursor, with confirmation window
+/           Type an op selection like *my_op+ and re-execute the steps it selects with Enter
+g           Open the stdout and stderr of the selected step
+u           Reload the steps
+ESC         Closes the Steps View
 
+Compute Logs - View
+--
+∧ v         Arrow Keys, Scroll through the output
+PgUp PgDn   Scroll a page up or down
+s           Toggle between stdout and stderr
+n           Load the next page of the output
+f           Toggle following new output of the step, enabled by default for running steps
+ESC         Closes the Compute Logs View
+
+Run Diff - View
+--
+∧ v         Arrow Keys, Scroll through the diff
+PgUp PgDn   Scroll a page up or down
+s           Toggle between the unified and the side by side diff
+ESC         Closes the Run Diff View and unmarks the runs
+
+Runs Search - View
+--
+Enter       Search with the filter typed into the filter line, space separated terms of
+            status:FAILURE,CANCELED job:name tag:key=value id:runId after:12h before:2006-01-02T15:04
+Tab         Switch between the filter line and the found runs
+∧ v         Arrow Keys, Scroll through the found runs
+n           Load the next page of runs
+ESC         Closes the Runs Search View
+
+Partitions - View
+--
+a-z 0-9     Typing filters the partitions
+∧ v         Arrow Keys, Scroll through the partitions
+Enter       Open Launch Window with the config and tags of the selected partition
+Space       Select or unselect the partition for a backfill
+ctrl + a    Select or unselect all partitions matching the filter
+ctrl + r    Select all partitions between the last selected one and the cursor
+ctrl + b    Launch a backfill for the selected partitions, or the one under the cursor
+ESC         Closes the Partitions View
+
+Backfills - View
+--
+∧ v         Arrow Keys, Scroll through the backfills
+c           Cancel the selected backfill with confirmation window
+r           Resume the selected backfill with confirmation window
+u           Reload the backfills
+ESC         Closes the Backfills View
+
+Schedules - View
+--
+∧ v         Arrow Keys, Scroll through the schedules
+s           Start the selected schedule with confirmation window
+S           Stop the selected schedule with confirmation window
+u           Reload the schedules
+ESC         Closes the Schedules View
+
+Sensors - View
+--
+∧ v         Arrow Keys, Scroll through the sensors, the last ticks of the selected sensor are shown below
+s           Start the selected sensor with confirmation window
+S           Stop the selected sensor with confirmation window
+c           Show the current cursor of the selected sensor
+u           Reload the sensors
+ESC         Closes the Sensors View
+
+Assets - View
+--
+∧ v         Arrow Keys, Scroll through the assets, grouped by code location and asset group
+PgUp PgDn   Scroll a page up or down
+Enter       Show the materialization history of the selected asset
+Space       Select or unselect the asset for materialization
+m           Open Launch Window to materialize the selected assets, or the one under the cursor,
+            partitioned assets get a field for the partition to materialize
+l           Show the up- and downstream assets of the selected asset, coloured by their latest materialization
+u           Reload the assets
+ESC         Closes the Assets View
 
 Filter - View
 --
@@ -34,7 +142,11 @@ Enter       Apply Filter to the list of items of the view from where the filter
 
 LaunchConfigEditor - View
 --
-ctrl + l	(Verifies TBD) and Launches a Run of the Job with the displayed config
+ctrl + l	Launches a Run of the Job with the displayed config, invalid configs are listed below the window
+ctrl + v	Validates the displayed config, highlights the lines with errors and shows the errors next to them
+ctrl + e	Edits the config in $VISUAL or $EDITOR, the edited config is loaded back when the editor exits
+Tab         Switch between the config editor, the run name, the partition and the tags pane, tags are entered as key=value lines
+Enter       In the run name and partition field, moves on to the next pane
 ESC			Closes the Launch Window, Changes are not saved
 ctrl + /    Toggle comment in selected line
 Arrow Keys  Navigation (TBD)
@@ -13,15 +13,39 @@ type BaseView struct {
 	StartX, StartY int
 	EndX, EndY     int
 	Title          string
+
+	// number of requests currently loading content for this view
+	loading int
+}
+
+func (w *BaseView) displayTitle() string {
+	if w.loading > 0 {
+		return fmt.Sprintf("%s (loading…)", w.Title)
+	}
+	return w.Title
 }
 
 func (w *BaseView) RenderView(g *c.Gui, sx int, sy int, ex int, ey int) error {
 	w.StartX, w.StartY, w.EndX, w.EndY = sx, sy, ex, ey
 	_, err := g.SetView(w.View.Name(), w.StartX, w.StartY, w.EndX, w.EndY)
-	w.View.Title = w.Title
+	w.View.Title = w.displayTitle()
 	return err
 }
 
+func (w *BaseView) SetTitle(title string) {
+	w.Title = title
+	w.View.Title = w.displayTitle()
+}
+
+func (w *BaseView) SetLoading(loading bool) {
+	if loading {
+		w.loading++
+	} else if w.loading > 0 {
+		w.loading--
+	}
+	w.View.Title = w.displayTitle()
+}
+
 func (w *BaseView) SetNavigableFeedback(g *c.Gui) {
 	w.View.SelFgColor = c.AttrBold
 	w.View.SelBgColor = c.ColorRed
@@ -59,6 +83,13 @@ func (w *InfoView) RenderContent(content []string) {
 	}
 }
 
+func (w *InfoView) AppendContent(content []string) {
+	w.Content = append(w.Content, content...)
+	for _, item := range content {
+		fmt.Fprintln(w.Base.View, item)
+	}
+}
+
 type ListView[T any] struct {
 	Base     *BaseView
 	Elements []string
@@ -83,13 +114,95 @@ func (w *ListView[T]) ResetCursor() {
 	w.Base.View.SetCursor(0, 0)
 }
 
+// SelectElement moves the cursor to the first element matching cond, returns false if there is none
+func (w *ListView[T]) SelectElement(cond func(T) bool) bool {
+	for index, element := range w.RawElements {
+		if cond(element) {
+			w.SetCursorTo(index)
+			return true
+		}
+	}
+	return false
+}
+
+func (w *ListView[T]) SetCursorTo(index int) {
+	if index < 0 || index >= len(w.Elements) {
+		return
+	}
+	_, h := w.Base.View.Size()
+	if index < h {
+		w.Base.View.SetOrigin(0, 0)
+		w.Base.View.SetCursor(0, index)
+	} else {
+		w.Base.View.SetOrigin(0, index-h+1)
+		w.Base.View.SetCursor(0, h-1)
+	}
+}
+
+// GetRawElementOnCursorPosition returns the element under the cursor, false if the list is empty
+func (w *ListView[T]) GetRawElementOnCursorPosition() (T, bool) {
+	_, oy := w.Base.View.Origin()
+	_, vy := w.Base.View.Cursor()
+
+	if vy+oy >= len(w.RawElements) {
+		var empty T
+		return empty, false
+	}
+	return w.RawElements[vy+oy], true
+}
+
 func (w *ListView[T]) GetElementOnCursorPosition() string {
 	_, oy := w.Base.View.Origin()
 	_, vy := w.Base.View.Cursor()
 
+	if vy+oy >= len(w.Elements) {
+		return ""
+	}
 	return w.Elements[vy+oy]
 }
 
+// RefreshItems renders the current elements again, for when their representation changed, the cursor stays in place
+func (w *ListView[T]) RefreshItems() {
+	ox, oy := w.Base.View.Origin()
+	x, y := w.Base.View.Cursor()
+	w.RenderItems(w.RawElements, false)
+	w.Base.View.SetOrigin(ox, oy)
+	w.Base.View.SetCursor(x, y)
+}
+
+// ReplaceItems renders a new version of the elements, the cursor stays on the element the same as the one it was
+// on and falls back to the same position when that element is gone
+func (w *ListView[T]) ReplaceItems(items []T, same func(T, T) bool, sort ...bool) {
+	current, ok := w.GetRawElementOnCursorPosition()
+	ox, oy := w.Base.View.Origin()
+	x, y := w.Base.View.Cursor()
+	w.RenderItems(items, sort...)
+
+	_, h := w.Base.View.Size()
+	index := oy + y
+	if ok {
+		for i, element := range w.RawElements {
+			if same(current, element) {
+				index = i
+				break
+			}
+		}
+	}
+	if len(w.RawElements) == 0 {
+		w.ResetCursor()
+		return
+	}
+	if index >= len(w.RawElements) {
+		index = len(w.RawElements) - 1
+	}
+	if index >= oy && index < oy+h {
+		w.Base.View.SetOrigin(ox, oy)
+		w.Base.View.SetCursor(x, index-oy)
+		return
+	}
+	w.SetCursorTo(index)
+}
+
 func (w *ListView[T]) RenderItems(items []T, sort ...bool) {
 	// default sort: true
 	w.RawElements = make([]T, 0)
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+)
+
+func yamlIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func isYamlContent(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" && !strings.HasPrefix(trimmed, "#")
+}
+
+// yamlKey returns the indentation and the key of a mapping entry, list items like "- key: value" count as
+// an entry that is indented by the dash
+func yamlKey(line string) (int, string) {
+	indent := yamlIndent(line)
+	content := strings.TrimSpace(line)
+	for strings.HasPrefix(content, "- ") {
+		content = strings.TrimLeft(content[1:], " ")
+		indent = len(line) - len(content)
+	}
+	index := strings.Index(content, ":")
+	if index < 0 {
+		return indent, ""
+	}
+	return indent, strings.Trim(content[:index], `"'`)
+}
+
+// FindYamlLine returns the index of the line in the yaml lines the path (e.g. ops, my_op, config, [0]) points to.
+// If the full path can not be found the line of the deepest match is returned, -1 if not even the first segment matches
+func FindYamlLine(lines []string, path []string) int {
+	found := -1
+	start, end := 0, len(lines)
+	parentIndent := -1
+	for _, segment := range path {
+		match := -1
+		if strings.HasPrefix(segment, "[") && strings.HasSuffix(segment, "]") {
+			listIndex, err := strconv.Atoi(segment[1 : len(segment)-1])
+			if err != nil {
+				break
+			}
+			match = findYamlListItem(lines, start, end, parentIndent, listIndex)
+		} else {
+			match = findYamlKey(lines, start, end, parentIndent, segment)
+		}
+		if match < 0 {
+			break
+		}
+		found = match
+
+		// continue searching in the block that belongs to the match
+		matchIndent, _ := yamlKey(lines[match])
+		if strings.HasPrefix(segment, "[") {
+			// the list item itself can hold the first key of a mapping, like "- key: value"
+			start = match
+		} else {
+			start = match + 1
+		}
+		parentIndent = matchIndent - 1
+		blockEnd := match + 1
+		for blockEnd < end && (!isYamlContent(lines[blockEnd]) || yamlIndent(lines[blockEnd]) >= matchIndent) {
+			// lists of a key may start at the same indentation as the key itself
+			sameIndent := isYamlContent(lines[blockEnd]) && yamlIndent(lines[blockEnd]) == matchIndent
+			if sameIndent && !strings.HasPrefix(segment, "[") && !strings.HasPrefix(strings.TrimSpace(lines[blockEnd]), "-") {
+				break
+			}
+			blockEnd++
+		}
+		end = blockEnd
+	}
+	return found
+}
+
+func findYamlKey(lines []string, start int, end int, parentIndent int, key string) int {
+	childIndent := -1
+	for i := start; i < end; i++ {
+		if !isYamlContent(lines[i]) {
+			continue
+		}
+		indent, lineKey := yamlKey(lines[i])
+		if indent <= parentIndent {
+			continue
+		}
+		if childIndent < 0 {
+			childIndent = indent
+		}
+		if indent == childIndent && lineKey == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func findYamlListItem(lines []string, start int, end int, parentIndent int, listIndex int) int {
+	itemIndent := -1
+	count := 0
+	for i := start; i < end; i++ {
+		if !isYamlContent(lines[i]) {
+			continue
+		}
+		indent := yamlIndent(lines[i])
+		if indent <= parentIndent || !strings.HasPrefix(strings.TrimSpace(lines[i]), "-") {
+			continue
+		}
+		if itemIndent < 0 {
+			itemIndent = indent
+		}
+		if indent != itemIndent {
+			continue
+		}
+		if count == listIndex {
+			return i
+		}
+		count++
+	}
+	return -1
+}
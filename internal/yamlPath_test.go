@@ -0,0 +1,109 @@
+package internal
+
+import "testing"
+
+func TestFindYamlLine(t *testing.T) {
+	config := []string{
+		"ops:",                 // 0
+		"  my_op:",             // 1
+		"    config:",          // 2
+		"      # a comment",    // 3
+		"      name: test",     // 4
+		"      \"quoted\": 1",  // 5
+		"      items:",         // 6
+		"      - first",        // 7
+		"      - key: second",  // 8
+		"        other: value", // 9
+		"  other_op:",          // 10
+		"    config:",          // 11
+		"      name: other",    // 12
+		"resources:",           // 13
+		"  io_manager:",        // 14
+		"    config: {}",       // 15
+	}
+
+	tests := []struct {
+		name string
+		path []string
+		want int
+	}{
+		{"top level key", []string{"resources"}, 13},
+		{"nested key", []string{"ops", "my_op", "config", "name"}, 4},
+		{"comment lines are skipped", []string{"ops", "my_op", "config"}, 2},
+		{"quoted key", []string{"ops", "my_op", "config", "quoted"}, 5},
+		{"same key in a sibling block", []string{"ops", "other_op", "config", "name"}, 12},
+		{"list item", []string{"ops", "my_op", "config", "items", "[0]"}, 7},
+		{"key on the line of a list item", []string{"ops", "my_op", "config", "items", "[1]", "key"}, 8},
+		{"key below a list item", []string{"ops", "my_op", "config", "items", "[1]", "other"}, 9},
+		{"missing key returns the deepest match", []string{"ops", "my_op", "config", "missing"}, 2},
+		{"list index out of range returns the list", []string{"ops", "my_op", "config", "items", "[5]"}, 6},
+		{"key of a deeper level is not matched", []string{"name"}, -1},
+		{"missing first segment", []string{"missing"}, -1},
+		{"empty path", []string{}, -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := FindYamlLine(config, test.path); got != test.want {
+				t.Errorf("FindYamlLine(%v) = %d, want %d", test.path, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFindYamlKey(t *testing.T) {
+	lines := []string{
+		"a:",
+		"  b: 1",
+		"  'c': 2",
+		"    d: 3",
+		"e: 4",
+	}
+	tests := []struct {
+		name         string
+		start, end   int
+		parentIndent int
+		key          string
+		want         int
+	}{
+		{"top level", 0, 5, -1, "e", 4},
+		{"child", 1, 4, 0, "b", 1},
+		{"single quoted", 1, 4, 0, "c", 2},
+		{"only direct children", 1, 4, 0, "d", -1},
+		{"outside of the block", 1, 4, 0, "e", -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := findYamlKey(lines, test.start, test.end, test.parentIndent, test.key); got != test.want {
+				t.Errorf("findYamlKey(%q) = %d, want %d", test.key, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFindYamlListItem(t *testing.T) {
+	lines := []string{
+		"items:",
+		"- a",
+		"-   - nested",
+		"    - nested too",
+		"",
+		"- b",
+	}
+	tests := []struct {
+		name  string
+		index int
+		want  int
+	}{
+		{"first item", 0, 1},
+		{"item holding a nested list", 1, 2},
+		{"nested items and empty lines are skipped", 2, 5},
+		{"out of range", 3, -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := findYamlListItem(lines, 1, len(lines), -1, test.index); got != test.want {
+				t.Errorf("findYamlListItem(%d) = %d, want %d", test.index, got, test.want)
+			}
+		})
+	}
+}